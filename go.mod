@@ -1,6 +1,6 @@
 module github.com/ta9mi141/toggl-go
 
-go 1.19
+go 1.23
 
 require (
 	github.com/google/go-querystring v1.1.0
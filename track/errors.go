@@ -1,12 +1,81 @@
 package track
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError represents an error response returned by Toggl API.
+// It can be retrieved by errors.As even if it's wrapped.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Message is the error message parsed from the response body.
+	Message string
+	// Body is the raw response body.
+	Body      string
+	RequestID string
+	// RetryAfter is zero if the response has no valid Retry-After header.
+	RetryAfter time.Duration
+	Header     http.Header
+}
+
+func (e *APIError) Error() string {
+	if e.Method == "" {
+		return fmt.Sprintf("%d %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.URL, e.StatusCode, e.Message)
+}
+
+// IsTemporaryError checks if the request can be retried later and also returns the value of Retry-After header.
+// Use RetryAfter for the parsed duration.
+func (e *APIError) IsTemporaryError() (bool, string) {
+	isTemporary := e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusServiceUnavailable
+	retryAfter := e.Header.Get("Retry-After")
+	return isTemporary, retryAfter
+}
+
+// IsTimeoutError checks if the server timed out.
+func (e *APIError) IsTimeoutError() bool {
+	return e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusGatewayTimeout
+}
+
+// ParseErrorMessage extracts the error message from the response body of Toggl API.
+// The body is either a JSON string, a JSON object which has a message, or a plain text.
+func ParseErrorMessage(statusCode int, body string) string {
+	trimmed := strings.TrimSpace(body)
+	if trimmed == "" {
+		return http.StatusText(statusCode)
+	}
+
+	var message string
+	if err := json.Unmarshal([]byte(trimmed), &message); err == nil {
+		return message
+	}
+	var object map[string]any
+	if err := json.Unmarshal([]byte(trimmed), &object); err == nil {
+		for _, key := range []string{"message", "error_message", "error", "tip"} {
+			if message, ok := object[key].(string); ok && message != "" {
+				return message
+			}
+		}
+	}
+	return trimmed
+}
+
 type temporaryError interface {
 	IsTemporaryError() (bool, string)
 }
 
 // IsTemporary checks if the error is temporary and also returns the value of Retry-After header.
 func IsTemporary(err error) (bool, string) {
-	if e, ok := err.(temporaryError); ok {
+	var e temporaryError
+	if errors.As(err, &e) {
 		return e.IsTemporaryError()
 	}
 	return false, ""
@@ -18,6 +87,31 @@ type timeoutError interface {
 
 // IsTimeout checks if the error was caused by a timeout.
 func IsTimeout(err error) bool {
-	e, ok := err.(timeoutError)
-	return ok && e.IsTimeoutError()
+	var e timeoutError
+	return errors.As(err, &e) && e.IsTimeoutError()
+}
+
+// IsNotFound checks if the error was caused by 404 Not Found.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsUnauthorized checks if the error was caused by 401 Unauthorized.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden checks if the error was caused by 403 Forbidden.
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsPaymentRequired checks if the error was caused by 402 Payment Required.
+func IsPaymentRequired(err error) bool {
+	return hasStatusCode(err, http.StatusPaymentRequired)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.StatusCode == statusCode
 }
@@ -0,0 +1,118 @@
+package track
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode int
+			body       string
+		}
+		out string
+	}{
+		{
+			name: "JSON string",
+			in: struct {
+				statusCode int
+				body       string
+			}{statusCode: http.StatusBadRequest, body: "\"JSON is not valid\"\n"},
+			out: "JSON is not valid",
+		},
+		{
+			name: "JSON object",
+			in: struct {
+				statusCode int
+				body       string
+			}{statusCode: http.StatusBadRequest, body: `{"error":"Maximum allowed date range is 365 days","code":400}`},
+			out: "Maximum allowed date range is 365 days",
+		},
+		{
+			name: "plain text",
+			in: struct {
+				statusCode int
+				body       string
+			}{statusCode: http.StatusForbidden, body: "Incorrect username and/or password\n"},
+			out: "Incorrect username and/or password",
+		},
+		{
+			name: "empty body",
+			in: struct {
+				statusCode int
+				body       string
+			}{statusCode: http.StatusNotFound, body: ""},
+			out: "Not Found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := ParseErrorMessage(tt.in.statusCode, tt.in.body)
+			if message != tt.out {
+				t.Errorf("\nwant: %q\ngot : %q\n", tt.out, message)
+			}
+		})
+	}
+}
+
+func TestAPIErrorThroughWrappedChain(t *testing.T) {
+	apiError := &APIError{
+		StatusCode: http.StatusTooManyRequests,
+		Method:     http.MethodGet,
+		URL:        "https://api.track.toggl.com/api/v9/me",
+		Message:    "Too Many Requests",
+		RetryAfter: 2 * time.Second,
+		Header:     http.Header{"Retry-After": []string{"2"}},
+	}
+	err := pkgerrors.Wrap(pkgerrors.Wrap(apiError, "failed to complete a request"), "failed to get me")
+
+	var got *APIError
+	if !errors.As(err, &got) || got != apiError {
+		t.Errorf("errors.As failed to find the APIError in %v", err)
+	}
+	if isTemporary, retryAfter := IsTemporary(err); !isTemporary || retryAfter != "2" {
+		t.Errorf("\nwant: %v, %q\ngot : %v, %q\n", true, "2", isTemporary, retryAfter)
+	}
+	if IsTimeout(err) {
+		t.Errorf("IsTimeout(%v) = true, want false", err)
+	}
+	want := "GET https://api.track.toggl.com/api/v9/me: 429 Too Many Requests"
+	if apiError.Error() != want {
+		t.Errorf("\nwant: %q\ngot : %q\n", want, apiError.Error())
+	}
+}
+
+func TestIsStatusCode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   func(error) bool
+		out  int
+	}{
+		{name: "IsNotFound", in: IsNotFound, out: http.StatusNotFound},
+		{name: "IsUnauthorized", in: IsUnauthorized, out: http.StatusUnauthorized},
+		{name: "IsForbidden", in: IsForbidden, out: http.StatusForbidden},
+		{name: "IsPaymentRequired", in: IsPaymentRequired, out: http.StatusPaymentRequired},
+		{name: "IsTimeout", in: IsTimeout, out: http.StatusGatewayTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pkgerrors.Wrap(&APIError{StatusCode: tt.out}, "failed to complete a request")
+			if !tt.in(err) {
+				t.Errorf("%s(%v) = false, want true", tt.name, err)
+			}
+			other := pkgerrors.Wrap(&APIError{StatusCode: http.StatusBadRequest}, "failed to complete a request")
+			if tt.in(other) {
+				t.Errorf("%s(%v) = true, want false", tt.name, other)
+			}
+			if tt.in(errors.New("not an API error")) {
+				t.Errorf("%s returned true for an error which is not an APIError", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+// enum is implemented by the typed string constants of the API packages, such as toggl.TagAction.
+type enum interface {
+	IsValid() bool
+}
+
+var enumType = reflect.TypeFor[enum]()
+
+var trackPkgPath = reflect.TypeFor[track.Nullable[int]]().PkgPath()
+
+// checkEnums records every field of v, including nested structs, slices, maps and track.Nullable,
+// which holds a value of an enum type which isn't one of the defined constants.
+func (validator *Validator) checkEnums(v reflect.Value, name string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Type().Implements(enumType) && v.Elem().Kind() == reflect.String {
+			validator.checkEnum(v.Interface().(enum), v.Elem().String(), name)
+			return
+		}
+		validator.checkEnums(v.Elem(), name)
+	case reflect.String:
+		// The zero value isn't checked because it's omitted from requests like a nil pointer.
+		if v.String() != "" && v.Type().Implements(enumType) {
+			validator.checkEnum(v.Interface().(enum), v.String(), name)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validator.checkEnums(v.Index(i), fmt.Sprintf("%s[%d]", name, i))
+		}
+	case reflect.Map:
+		// track.Nullable is a map, but its value is reported by the name of the field itself.
+		if isNullable(v.Type()) {
+			if value := v.MapIndex(reflect.ValueOf(true)); value.IsValid() {
+				validator.checkEnums(value, name)
+			}
+			return
+		}
+		keys := v.MapKeys()
+		// Map keys are sorted so that the errors are reported in a stable order.
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		for _, key := range keys {
+			validator.checkEnums(v.MapIndex(key), fmt.Sprintf("%s[%v]", name, key.Interface()))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			if name == "" {
+				validator.checkEnums(v.Field(i), fieldName(t.Field(i)))
+			} else {
+				validator.checkEnums(v.Field(i), name+"."+fieldName(t.Field(i)))
+			}
+		}
+	}
+}
+
+// isNullable reports whether t is an instance of track.Nullable.
+func isNullable(t reflect.Type) bool {
+	return t.PkgPath() == trackPkgPath && strings.HasPrefix(t.Name(), "Nullable[")
+}
+
+func (validator *Validator) checkEnum(e enum, value, name string) {
+	validator.Check(e.IsValid(), name, fmt.Sprintf("%q is not a valid value", value))
+}
+
+// fieldName returns the name of the field in JSON or the query string, or the name in Go if it has no tag.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "url"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
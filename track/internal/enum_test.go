@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+type color string
+
+const (
+	red  color = "red"
+	blue color = "blue"
+)
+
+func (c color) IsValid() bool {
+	return c == red || c == blue
+}
+
+func TestValidatorCheckEnums(t *testing.T) {
+	type item struct {
+		Color *color `json:"color,omitempty"`
+	}
+	type input struct {
+		Color  *color                  `json:"color,omitempty"`
+		Shade  color                   `url:"shade,omitempty"`
+		Items  []*item                 `json:"items,omitempty"`
+		Name   *string                 `json:"name,omitempty"`
+		Labels map[string]*color       `json:"labels,omitempty"`
+		Tint   track.Nullable[color]   `json:"tint,omitempty"`
+		Rows   track.Nullable[[]*item] `json:"rows,omitempty"`
+		hidden *color
+	}
+	invalid := color("green")
+	tests := []struct {
+		name string
+		in   any
+		out  string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "valid",
+			in:   &input{Color: func() *color { c := red; return &c }(), Shade: blue, Items: []*item{{}}, hidden: &invalid},
+			out:  "",
+		},
+		{
+			name: "invalid pointer",
+			in:   &input{Color: &invalid},
+			out:  "invalid request: color: \"green\" is not a valid value",
+		},
+		{
+			name: "invalid value",
+			in:   input{Shade: "green"},
+			out:  "invalid request: shade: \"green\" is not a valid value",
+		},
+		{
+			name: "invalid in slice",
+			in:   &input{Items: []*item{nil, {Color: &invalid}}},
+			out:  "invalid request: items[1].color: \"green\" is not a valid value",
+		},
+		{
+			name: "valid map and nullable",
+			in:   &input{Labels: map[string]*color{"a": nil}, Tint: track.NewNullable(red), Rows: track.Null[[]*item]()},
+			out:  "",
+		},
+		{
+			name: "invalid in map",
+			in:   &input{Labels: map[string]*color{"b": &invalid, "a": nil}},
+			out:  "invalid request: labels[b]: \"green\" is not a valid value",
+		},
+		{
+			name: "invalid in nullable",
+			in:   &input{Tint: track.NewNullable(invalid)},
+			out:  "invalid request: tint: \"green\" is not a valid value",
+		},
+		{
+			name: "invalid in nested nullable",
+			in:   &input{Rows: track.NewNullable([]*item{{Color: &invalid}})},
+			out:  "invalid request: rows[0].color: \"green\" is not a valid value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(tt.in).Err()
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.out {
+				Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
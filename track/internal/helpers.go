@@ -8,7 +8,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
+
+	"github.com/ta9mi141/toggl-go/track"
 )
 
 const (
@@ -53,8 +57,10 @@ func NewMockServer(t *testing.T, apiSpecificPath string, statusCode int, testdat
 }
 
 func NewMockServerToAssertRequestBody(t *testing.T, expectedRequestBody string) *httptest.Server {
+	received := assertRequestReceived(t)
 	// The caller should call Close to shut down the server.
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Store(true)
 		rawRequestBody, err := io.ReadAll(r.Body)
 		if err != nil {
 			t.Fatal(err.Error())
@@ -67,12 +73,61 @@ func NewMockServerToAssertRequestBody(t *testing.T, expectedRequestBody string)
 }
 
 func NewMockServerToAssertQuery(t *testing.T, expectedQuery string) *httptest.Server {
+	received := assertRequestReceived(t)
 	// The caller should call Close to shut down the server.
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Store(true)
 		actualQuery := r.URL.Query().Encode()
 		if actualQuery != expectedQuery {
 			Errorf(t, actualQuery, expectedQuery)
 		}
 	}))
+}
+
+// assertRequestReceived fails the test at the end unless the returned flag is set,
+// so that a request which is rejected before being sent, e.g. by validation, isn't overlooked.
+func assertRequestReceived(t *testing.T) *atomic.Bool {
+	var received atomic.Bool
+	t.Cleanup(func() {
+		if !received.Load() {
+			t.Error("no request was received by the mock server")
+		}
+	})
+	return &received
+}
 
+// NewMockServerToCountRequests returns a mock server which responds null to any request
+// and a function which returns the number of requests keyed by the method and the path, e.g. "GET /api/v9/me".
+func NewMockServerToCountRequests(t *testing.T) (*httptest.Server, func() map[string]int) {
+	var mu sync.Mutex
+	counts := make(map[string]int)
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprint(w, "null")
+	}))
+
+	// The caller should call Close to shut down the server.
+	return mockServer, func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+		result := make(map[string]int, len(counts))
+		for k, v := range counts {
+			result[k] = v
+		}
+		return result
+	}
+}
+
+// EqualAPIError reports whether got has the same status code, message and body as want.
+// The other fields are ignored because they depend on the mock server and the time of the request.
+func EqualAPIError(got *track.APIError, want error) bool {
+	w, ok := want.(*track.APIError)
+	if !ok {
+		return false
+	}
+	return got.StatusCode == w.StatusCode && got.Message == w.Message && got.Body == w.Body
 }
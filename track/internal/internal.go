@@ -5,11 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
 )
 
 const (
@@ -17,10 +20,29 @@ const (
 	BasicAuthPassword string = "api_token" // Defined in Toggl Track API
 )
 
+// File represents a file which is uploaded as multipart/form-data by NewRequest.
+type File struct {
+	FieldName string
+	FileName  string
+	Content   io.Reader
+}
+
 func NewRequest(ctx context.Context, httpMethod string, url *url.URL, input any) (*http.Request, error) {
 	requestBody := io.Reader(nil)
+	contentType := "application/json"
 	switch httpMethod {
-	case http.MethodPost, http.MethodPut:
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		if input == nil { // Some endpoints such as stopping a time entry take no request body.
+			break
+		}
+		if file, ok := input.(*File); ok {
+			b, formDataContentType, err := encodeMultipart(file)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encode file")
+			}
+			requestBody, contentType = bytes.NewReader(b), formDataContentType
+			break
+		}
 		b, err := json.Marshal(input)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal input")
@@ -39,31 +61,91 @@ func NewRequest(ctx context.Context, httpMethod string, url *url.URL, input any)
 		return nil, errors.Wrap(err, "failed to create a new request with context")
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	return req, nil
 }
 
-func Do(client *http.Client, req *http.Request, respBody any) error {
-	resp, err := client.Do(req)
+// encodeMultipart encodes the file into a body of multipart/form-data, and returns it with its content type.
+// The body is buffered so that the request can be retried.
+func encodeMultipart(file *File) ([]byte, string, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	part, err := writer.CreateFormFile(file.FieldName, file.FileName)
 	if err != nil {
-		return errors.Wrap(err, "failed to send a request")
+		return nil, "", err
 	}
+	if _, err := io.Copy(part, file.Content); err != nil {
+		return nil, "", err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
 
-	err = checkResponse(resp)
+// Do sends a request and decodes the response body into respBody.
+// It returns the header of the response so that callers can read metadata such as pagination cursors.
+// If retryPolicy is not nil, the request is retried when it failed temporarily.
+// If rateLimiter is not nil, every attempt waits for the rate limiter.
+func Do(client *http.Client, req *http.Request, respBody any, retryPolicy *track.RetryPolicy, rateLimiter *track.RateLimiter) (http.Header, error) {
+	resp, err := send(client, req, retryPolicy, rateLimiter)
 	if err != nil {
-		return errors.Wrap(err, "failed to complete a request")
+		return nil, err
 	}
 
 	switch req.Method {
-	case http.MethodGet, http.MethodPost, http.MethodPut:
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch:
 		err = decodeJSON(resp, respBody)
 		if err != nil {
-			return errors.Wrap(err, "failed to decode response body")
+			return nil, errors.Wrap(err, "failed to decode response body")
 		}
+	default:
+		resp.Body.Close()
 	}
 
-	return nil
+	return resp.Header, nil
+}
+
+func send(client *http.Client, req *http.Request, retryPolicy *track.RetryPolicy, rateLimiter *track.RateLimiter) (*http.Response, error) {
+	if retryPolicy != nil {
+		if err := bufferBody(req); err != nil {
+			return nil, errors.Wrap(err, "failed to buffer request body")
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if rateLimiter != nil {
+			if err := rateLimiter.Wait(req.Context()); err != nil {
+				return nil, errors.Wrap(err, "failed to wait for rate limiter")
+			}
+		}
+
+		r, err := rewind(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to rewind a request")
+		}
+
+		resp, err := client.Do(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to send a request")
+		}
+
+		err = checkResponse(resp)
+		if err == nil {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		apiError, ok := err.(*track.APIError)
+		if !ok || !shouldRetry(req, retryPolicy, attempt, apiError) {
+			return nil, errors.Wrap(err, "failed to complete a request")
+		}
+
+		if err := sleep(req.Context(), backoff(retryPolicy, attempt, apiError.Header)); err != nil {
+			return nil, errors.Wrap(err, "failed to wait for a retry")
+		}
+	}
 }
 
 func checkResponse(resp *http.Response) error {
@@ -72,18 +154,36 @@ func checkResponse(resp *http.Response) error {
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{StatusCode: resp.StatusCode, Header: resp.Header}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return errors.Wrap(err, "failed to read error response")
 	}
-	errorResponse.Message = string(body)
 
-	return errorResponse
+	apiError := &track.APIError{
+		StatusCode: resp.StatusCode,
+		Message:    track.ParseErrorMessage(resp.StatusCode, string(body)),
+		Body:       string(body),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Header:     resp.Header,
+	}
+	if resp.Request != nil {
+		apiError.Method = resp.Request.Method
+		apiError.URL = resp.Request.URL.String()
+	}
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		apiError.RetryAfter = retryAfter
+	}
+
+	return apiError
 }
 
+// decodeJSON decodes the response body into out, or discards it if out is nil.
 func decodeJSON(resp *http.Response, out any) error {
 	defer resp.Body.Close()
+	if out == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
+	}
 	decoder := json.NewDecoder(resp.Body)
 	return decoder.Decode(out)
 }
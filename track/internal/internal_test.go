@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+func TestDoReturnsAPIError(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Request-Id", "0123456789abcdef")
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("\"Too many requests\"\n"))
+	}))
+	defer mockServer.Close()
+
+	u, _ := url.Parse(mockServer.URL + "/api/v9/me")
+	req, err := NewRequest(context.Background(), http.MethodGet, u, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = Do(http.DefaultClient, req, nil, nil, nil)
+
+	apiError := new(track.APIError)
+	if !errors.As(err, &apiError) {
+		t.Fatalf("errors.As failed to find the APIError in %v", err)
+	}
+	want := &track.APIError{
+		StatusCode: http.StatusTooManyRequests,
+		Method:     http.MethodGet,
+		URL:        mockServer.URL + "/api/v9/me",
+		Message:    "Too many requests",
+		Body:       "\"Too many requests\"\n",
+		RequestID:  "0123456789abcdef",
+		RetryAfter: 30 * time.Second,
+	}
+	if isTemporary, retryAfter := track.IsTemporary(err); !isTemporary || retryAfter != "30" {
+		Errorf(t, []any{isTemporary, retryAfter}, []any{true, "30"})
+	}
+	apiError.Header = nil
+	if !reflect.DeepEqual(apiError, want) {
+		Errorf(t, apiError, want)
+	}
+}
+
+func TestNewRequestWithFile(t *testing.T) {
+	u, _ := url.Parse("https://api.track.toggl.com/api/v9/workspaces/1234567/logo")
+	file := &File{FieldName: "file", FileName: "logo.png", Content: strings.NewReader("PNG")}
+	req, err := NewRequest(context.Background(), http.MethodPost, u, file)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := req.ParseMultipartForm(1 << 20); err != nil {
+		t.Fatal(err.Error())
+	}
+	fileHeaders := req.MultipartForm.File["file"]
+	if len(fileHeaders) != 1 || fileHeaders[0].Filename != "logo.png" {
+		Errorf(t, fileHeaders, "logo.png")
+	}
+	f, err := fileHeaders[0].Open()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer f.Close()
+	content, _ := io.ReadAll(f)
+	if string(content) != "PNG" {
+		Errorf(t, string(content), "PNG")
+	}
+}
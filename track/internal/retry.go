@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+// MarkIdempotent marks the request as idempotent regardless of its method.
+// It follows the convention of net/http, so the header is not sent on the wire.
+func MarkIdempotent(req *http.Request) {
+	req.Header["Idempotency-Key"] = nil
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if _, ok := req.Header["Idempotency-Key"]; ok {
+		return true
+	}
+	_, ok := req.Header["X-Idempotency-Key"]
+	return ok
+}
+
+func shouldRetry(req *http.Request, policy *track.RetryPolicy, attempt int, apiError *track.APIError) bool {
+	if policy == nil || attempt >= policy.MaxAttempts {
+		return false
+	}
+	if isTemporary, _ := apiError.IsTemporaryError(); !isTemporary {
+		return false
+	}
+	return policy.RetryNonIdempotent || isIdempotent(req)
+}
+
+// backoff returns the interval before the next attempt.
+// The attempt is the number of attempts which have already been made.
+func backoff(policy *track.RetryPolicy, attempt int, header http.Header) time.Duration {
+	if retryAfter, ok := parseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+		return retryAfter
+	}
+
+	interval := policy.MinBackoff
+	for i := 1; i < attempt && (policy.MaxBackoff <= 0 || interval < policy.MaxBackoff); i++ {
+		interval *= 2
+	}
+	if policy.MaxBackoff > 0 && interval > policy.MaxBackoff {
+		interval = policy.MaxBackoff
+	}
+	if interval <= 0 {
+		return 0
+	}
+	// Equal jitter keeps at least half of the interval to avoid retrying immediately.
+	half := interval / 2
+	return half + time.Duration(rand.Int63n(int64(interval-half)+1))
+}
+
+// parseRetryAfter parses the value of Retry-After header, which is either seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := date.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// bufferBody makes it possible to send the request body again on retries.
+func bufferBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read request body")
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	return nil
+}
+
+// rewind returns a copy of the request whose body is read from the beginning.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get request body")
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,276 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   string
+		out  struct {
+			retryAfter time.Duration
+			ok         bool
+		}
+	}{
+		{
+			name: "empty",
+			in:   "",
+			out: struct {
+				retryAfter time.Duration
+				ok         bool
+			}{retryAfter: 0, ok: false},
+		},
+		{
+			name: "seconds",
+			in:   "3",
+			out: struct {
+				retryAfter time.Duration
+				ok         bool
+			}{retryAfter: 3 * time.Second, ok: true},
+		},
+		{
+			name: "HTTP date",
+			in:   "Sun, 02 Jan 2022 03:04:15 GMT",
+			out: struct {
+				retryAfter time.Duration
+				ok         bool
+			}{retryAfter: 10 * time.Second, ok: true},
+		},
+		{
+			name: "HTTP date in the past",
+			in:   "Sun, 02 Jan 2022 03:04:00 GMT",
+			out: struct {
+				retryAfter time.Duration
+				ok         bool
+			}{retryAfter: 0, ok: true},
+		},
+		{
+			name: "invalid",
+			in:   "soon",
+			out: struct {
+				retryAfter time.Duration
+				ok         bool
+			}{retryAfter: 0, ok: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryAfter, ok := parseRetryAfter(tt.in, now)
+			if retryAfter != tt.out.retryAfter || ok != tt.out.ok {
+				Errorf(t, []any{retryAfter, ok}, []any{tt.out.retryAfter, tt.out.ok})
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := &track.RetryPolicy{MaxAttempts: 10, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		d := backoff(policy, attempt+1, http.Header{})
+		if d < max/2 || d > max {
+			t.Errorf("attempt %d: backoff %v is out of [%v, %v]", attempt+1, d, max/2, max)
+		}
+	}
+
+	header := http.Header{"Retry-After": []string{"2"}}
+	if d := backoff(policy, 1, header); d != 2*time.Second {
+		Errorf(t, d, 2*time.Second)
+	}
+}
+
+func newRetryTestServer(t *testing.T, failures int32, count *int32, bodies chan<- string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if bodies != nil {
+			bodies <- string(body)
+		}
+		if atomic.AddInt32(count, 1) <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Write([]byte(`{"id":1}`))
+	}))
+}
+
+func TestDoWithRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			method      string
+			idempotent  bool
+			failures    int32
+			retryPolicy *track.RetryPolicy
+		}
+		out struct {
+			attempts int32
+			ok       bool
+		}
+	}{
+		{
+			name: "GET succeeds after retries",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodGet, failures: 2, retryPolicy: &track.RetryPolicy{MaxAttempts: 3}},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 3, ok: true},
+		},
+		{
+			name: "GET gives up after max attempts",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodGet, failures: 5, retryPolicy: &track.RetryPolicy{MaxAttempts: 3}},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 3, ok: false},
+		},
+		{
+			name: "no retry policy",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodGet, failures: 1, retryPolicy: nil},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 1, ok: false},
+		},
+		{
+			name: "POST is not retried",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodPost, failures: 1, retryPolicy: &track.RetryPolicy{MaxAttempts: 3}},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 1, ok: false},
+		},
+		{
+			name: "POST marked as idempotent is retried",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodPost, idempotent: true, failures: 1, retryPolicy: &track.RetryPolicy{MaxAttempts: 3}},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 2, ok: true},
+		},
+		{
+			name: "POST is retried if RetryNonIdempotent is true",
+			in: struct {
+				method      string
+				idempotent  bool
+				failures    int32
+				retryPolicy *track.RetryPolicy
+			}{method: http.MethodPost, failures: 1, retryPolicy: &track.RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true}},
+			out: struct {
+				attempts int32
+				ok       bool
+			}{attempts: 2, ok: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int32
+			bodies := make(chan string, 10)
+			mockServer := newRetryTestServer(t, tt.in.failures, &count, bodies)
+			defer mockServer.Close()
+
+			u, _ := url.Parse(mockServer.URL)
+			input := &struct {
+				Name string `json:"name" url:"name"`
+			}{Name: "MyProject"}
+			req, err := NewRequest(context.Background(), tt.in.method, u, input)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if tt.in.idempotent {
+				MarkIdempotent(req)
+			}
+
+			var respBody struct {
+				ID int `json:"id"`
+			}
+			_, err = Do(http.DefaultClient, req, &respBody, tt.in.retryPolicy, nil)
+
+			if count != tt.out.attempts {
+				Errorf(t, count, tt.out.attempts)
+			}
+			if (err == nil) != tt.out.ok {
+				Errorf(t, err, tt.out.ok)
+			}
+			if tt.out.ok && respBody.ID != 1 {
+				Errorf(t, respBody.ID, 1)
+			}
+			close(bodies)
+			if tt.in.method == http.MethodPost {
+				for body := range bodies {
+					if body != `{"name":"MyProject"}` {
+						Errorf(t, body, `{"name":"MyProject"}`)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDoWithRetryPolicyCanceled(t *testing.T) {
+	var count int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	u, _ := url.Parse(mockServer.URL)
+	req, err := NewRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = Do(http.DefaultClient, req, nil, &track.RetryPolicy{MaxAttempts: 3}, nil)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		Errorf(t, err, context.DeadlineExceeded)
+	}
+	if count != 1 {
+		Errorf(t, count, 1)
+	}
+}
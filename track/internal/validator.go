@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+const dateLayout string = "2006-01-02"
+
+// Validator collects the invalid fields of a request body or a query.
+type Validator struct {
+	fields []*track.FieldError
+}
+
+// NewValidator creates a Validator which has already checked the enums in input.
+func NewValidator(input any) *Validator {
+	v := &Validator{}
+	v.checkEnums(reflect.ValueOf(input), "")
+	return v
+}
+
+// Check records the field as invalid with the message unless ok is true.
+func (v *Validator) Check(ok bool, field, message string) {
+	if !ok {
+		v.fields = append(v.fields, &track.FieldError{Field: field, Message: message})
+	}
+}
+
+// Required records the field as invalid if it's nil or an empty string.
+func (v *Validator) Required(value any, field string) {
+	rv := reflect.ValueOf(value)
+	ok := rv.IsValid() && !(rv.Kind() == reflect.Pointer && rv.IsNil())
+	if ok && rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.String {
+		ok = rv.Elem().String() != ""
+	}
+	if ok && rv.Kind() == reflect.Slice {
+		ok = rv.Len() > 0
+	}
+	v.Check(ok, field, "is required")
+}
+
+// Date records the field as invalid if it's not nil and not in the format of YYYY-MM-DD.
+// It returns the parsed date, or nil if the date is missing or invalid.
+func (v *Validator) Date(date *string, field string) *time.Time {
+	if date == nil {
+		return nil
+	}
+	t, err := time.Parse(dateLayout, *date)
+	if err != nil {
+		v.Check(false, field, "must be in the format of YYYY-MM-DD")
+		return nil
+	}
+	return &t
+}
+
+// NotEmpty records the field as invalid if it's an empty string, though it may be nil.
+func (v *Validator) NotEmpty(value *string, field string) {
+	v.Check(value == nil || *value != "", field, "must not be empty")
+}
+
+// DateOrTime records the field as invalid if it's not nil and in neither the format of YYYY-MM-DD nor RFC 3339.
+// It returns the parsed date or time, or nil if it is missing or invalid.
+func (v *Validator) DateOrTime(dateOrTime *string, field string) *time.Time {
+	if dateOrTime == nil {
+		return nil
+	}
+	for _, layout := range []string{dateLayout, time.RFC3339} {
+		if t, err := time.Parse(layout, *dateOrTime); err == nil {
+			return &t
+		}
+	}
+	v.Check(false, field, "must be in the format of YYYY-MM-DD or RFC 3339")
+	return nil
+}
+
+// NotBefore records the field as invalid if both times are not nil and t is before other.
+func (v *Validator) NotBefore(t, other *time.Time, field, otherField string) {
+	v.Check(t == nil || other == nil || !t.Before(*other), field, "must not be before "+otherField)
+}
+
+// Positive records the field as invalid if it's not nil and not greater than zero.
+func (v *Validator) Positive(value *int, field string) {
+	v.Check(value == nil || *value > 0, field, "must be greater than 0")
+}
+
+// Err returns a *track.ValidationError of all the invalid fields, or nil if there are none.
+func (v *Validator) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &track.ValidationError{Fields: v.fields}
+}
+
+// validatable is implemented by the request bodies and the queries of the API packages.
+type validatable interface {
+	Validate() error
+}
+
+// Validate validates input by its Validate method, or only checks its enums if it doesn't have one.
+func Validate(input any) error {
+	if input == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(input); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	if v, ok := input.(validatable); ok {
+		return v.Validate()
+	}
+	return NewValidator(input).Err()
+}
@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ta9mi141/toggl-go/track"
+)
+
+type validatableInput struct {
+	Name  *string `json:"name,omitempty"`
+	Color *color  `json:"color,omitempty"`
+}
+
+func (v *validatableInput) Validate() error {
+	validator := NewValidator(v)
+	validator.Required(v.Name, "name")
+	return validator.Err()
+}
+
+func TestValidate(t *testing.T) {
+	invalid := color("green")
+	tests := []struct {
+		name string
+		in   any
+		out  string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "nil pointer",
+			in:   (*validatableInput)(nil),
+			out:  "",
+		},
+		{
+			name: "with Validate",
+			in:   &validatableInput{Color: &invalid},
+			out:  "invalid request: color: \"green\" is not a valid value; name: is required",
+		},
+		{
+			name: "without Validate",
+			in:   &struct{ Name *string }{},
+			out:  "",
+		},
+		{
+			name: "slice",
+			in:   []*validatableInput{{Color: &invalid}},
+			out:  "invalid request: [0].color: \"green\" is not a valid value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.in)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.out {
+				Errorf(t, got, tt.out)
+			}
+			if err != nil && !errors.As(err, new(*track.ValidationError)) {
+				Errorf(t, err, &track.ValidationError{})
+			}
+		})
+	}
+}
+
+func TestValidatorDate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *string
+		out  string
+	}{
+		{name: "nil", in: nil, out: ""},
+		{name: "date", in: track.Ptr("2023-01-31"), out: ""},
+		{name: "time", in: track.Ptr("2023-01-31T00:00:00Z"), out: "invalid request: start_date: must be in the format of YYYY-MM-DD"},
+		{name: "invalid date", in: track.Ptr("2023-02-30"), out: "invalid request: start_date: must be in the format of YYYY-MM-DD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(nil)
+			validator.Date(tt.in, "start_date")
+			err := validator.Err()
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.out {
+				Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package track
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded is returned by RateLimiter.Wait
+// when the context would be done before a request is allowed.
+// The returned error also wraps context.DeadlineExceeded.
+var ErrRateLimitExceeded = errors.New("rate limit would exceed context deadline")
+
+// RateLimiter is a token bucket limiting the rate of requests on the client side.
+// Toggl limits requests per API token, so a RateLimiter should be shared by all clients using the same token.
+// It is safe for concurrent use by multiple goroutines.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	tokens   float64
+	last     time.Time
+}
+
+// NewRateLimiter creates a RateLimiter which allows a request every interval with bursts of at most burst requests.
+// The bucket is initially full.
+func NewRateLimiter(interval time.Duration, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		interval: interval,
+		burst:    burst,
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	wait := l.reserve(now)
+	if wait <= 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Sub(now) < wait {
+		l.cancel()
+		return fmt.Errorf("%w: %w", ErrRateLimitExceeded, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.interval <= 0 {
+		return 0
+	}
+	if now.After(l.last) {
+		l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+		if l.tokens > float64(l.burst) {
+			l.tokens = float64(l.burst)
+		}
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel gives back a token reserved by a caller which stopped waiting.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
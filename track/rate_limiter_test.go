@@ -0,0 +1,55 @@
+package track
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	interval := 20 * time.Millisecond
+	rateLimiter := NewRateLimiter(interval, 2)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := rateLimiter.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The first 2 requests are allowed by the burst, and the remaining 3 wait for an interval each.
+	if elapsed := time.Since(start); elapsed < 3*interval {
+		t.Errorf("5 requests finished in %v, want at least %v", elapsed, 3*interval)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	rateLimiter := NewRateLimiter(time.Hour, 1)
+	if err := rateLimiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := rateLimiter.Wait(ctx)
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("\nwant: %v\ngot : %v\n", ErrRateLimitExceeded, err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("\nwant: %v\ngot : %v\n", context.DeadlineExceeded, err)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if err := rateLimiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("\nwant: %v\ngot : %v\n", context.Canceled, err)
+	}
+}
@@ -0,0 +1,95 @@
+package reports
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestAPIClientConcurrentUse(t *testing.T) {
+	const (
+		workspaceID = 1234567
+		projectID   = 2345678
+		parallelism = 10
+	)
+	endpoints := []struct {
+		name   string
+		method string
+		path   string
+		call   func(ctx context.Context, c *APIClient) error
+	}{
+		{
+			name:   "SearchDetailedReport",
+			method: http.MethodPost,
+			path:   "/reports/api/v3/workspace/1234567/search/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.SearchDetailedReport(ctx, workspaceID, &SearchDetailedReportRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "SearchSummaryReport",
+			method: http.MethodPost,
+			path:   "/reports/api/v3/workspace/1234567/summary/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.SearchSummaryReport(ctx, workspaceID, &SearchSummaryReportRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "LoadProjectSummary",
+			method: http.MethodPost,
+			path:   "/reports/api/v3/workspace/1234567/projects/2345678/summary",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.LoadProjectSummary(ctx, workspaceID, projectID, &LoadProjectSummaryRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "SearchWeeklyReport",
+			method: http.MethodPost,
+			path:   "/reports/api/v3/workspace/1234567/weekly/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.SearchWeeklyReport(ctx, workspaceID, &SearchWeeklyReportRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "ListProjects",
+			method: http.MethodPost,
+			path:   "/reports/api/v3/workspace/1234567/filters/projects",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.ListProjects(ctx, workspaceID, &ListProjectsRequestBody{})
+				return err
+			},
+		},
+	}
+
+	mockServer, counts := internal.NewMockServerToCountRequests(t)
+	defer mockServer.Close()
+	apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+
+	want := make(map[string]int)
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		want[endpoint.method+" "+endpoint.path] += parallelism
+		for i := 0; i < parallelism; i++ {
+			wg.Add(1)
+			go func(name string, call func(ctx context.Context, c *APIClient) error) {
+				defer wg.Done()
+				if err := call(context.Background(), apiClient); err != nil {
+					t.Errorf("%s: %v", name, err)
+				}
+			}(endpoint.name, endpoint.call)
+		}
+	}
+	wg.Wait()
+
+	if got := counts(); !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+}
@@ -2,15 +2,21 @@ package reports
 
 import (
 	"context"
+	"iter"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // DetailedReport represents the properties of a detailed report.
-type DetailedReport []struct {
+type DetailedReport []DetailedReportRow
+
+// DetailedReportRow represents the properties of a row in a detailed report.
+type DetailedReportRow struct {
 	UserID                *int         `json:"user_id,omitempty"`
 	Username              *string      `json:"username,omitempty"`
 	ProjectID             *int         `json:"project_id,omitempty"`
@@ -47,8 +53,8 @@ type SearchDetailedReportRequestBody struct {
 	HideAmounts        *bool      `json:"hide_amounts,omitempty"`
 	MaxDurationSeconds *int       `json:"max_duration_seconds,omitempty"`
 	MinDurationSeconds *int       `json:"min_duration_seconds,omitempty"`
-	OrderBy            *string    `json:"order_by,omitempty"`
-	OrderDir           *string    `json:"order_dir,omitempty"`
+	OrderBy            *OrderBy   `json:"order_by,omitempty"`
+	OrderDir           *OrderDir  `json:"order_dir,omitempty"`
 	PostedFields       []*string  `json:"postedFields,omitempty"`
 	ProjectIDs         []*int     `json:"project_ids,omitempty"`
 	Rounding           *int       `json:"rounding,omitempty"`
@@ -61,12 +67,110 @@ type SearchDetailedReportRequestBody struct {
 	UserIDs            []*int     `json:"user_ids,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *SearchDetailedReportRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	validatePeriod(v, r.StartDate, r.EndDate)
+	validateDurationRange(v, r.MinDurationSeconds, r.MaxDurationSeconds)
+	return v.Err()
+}
+
 // SearchDetailedReport returns time entries for detailed report.
 func (c *APIClient) SearchDetailedReport(ctx context.Context, workspaceID int, reqBody *SearchDetailedReportRequestBody) (*DetailedReport, error) {
 	var detailedReport *DetailedReport
 	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "search/time_entries")
-	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &detailedReport); err != nil {
+	if _, err := c.httpPost(ctx, apiSpecificPath, reqBody, &detailedReport); err != nil {
 		return nil, errors.Wrap(err, "failed to search detailed report")
 	}
 	return detailedReport, nil
 }
+
+// DetailedReportPage represents a page of a detailed report with the cursor of the next page.
+// The cursor is given by X-Next-ID, X-Next-Row-Number, and X-Next-Timestamp headers, which are absent on the last page.
+type DetailedReportPage struct {
+	DetailedReport *DetailedReport
+	NextID         *int
+	NextRowNumber  *int
+	NextTimestamp  *int
+}
+
+// HasNext reports whether there is a next page.
+func (p *DetailedReportPage) HasNext() bool {
+	return p.NextID != nil || p.NextRowNumber != nil || p.NextTimestamp != nil
+}
+
+// SearchDetailedReportPage returns a page of time entries for detailed report with the cursor of the next page.
+func (c *APIClient) SearchDetailedReportPage(ctx context.Context, workspaceID int, reqBody *SearchDetailedReportRequestBody) (*DetailedReportPage, error) {
+	var detailedReport *DetailedReport
+	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "search/time_entries")
+	header, err := c.httpPost(ctx, apiSpecificPath, reqBody, &detailedReport)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search detailed report page")
+	}
+
+	page := &DetailedReportPage{DetailedReport: detailedReport}
+	for _, cursor := range []struct {
+		key   string
+		value **int
+	}{
+		{key: "X-Next-ID", value: &page.NextID},
+		{key: "X-Next-Row-Number", value: &page.NextRowNumber},
+		{key: "X-Next-Timestamp", value: &page.NextTimestamp},
+	} {
+		if v := header.Get(cursor.key); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s header", cursor.key)
+			}
+			*cursor.value = track.Ptr(n)
+		}
+	}
+	return page, nil
+}
+
+// AllDetailedReportRows returns an iterator over the rows of detailed report across all pages.
+// The iteration stops at the first error, including the cancellation of ctx.
+func (c *APIClient) AllDetailedReportRows(ctx context.Context, workspaceID int, reqBody *SearchDetailedReportRequestBody) iter.Seq2[*DetailedReportRow, error] {
+	return func(yield func(*DetailedReportRow, error) bool) {
+		body := SearchDetailedReportRequestBody{}
+		if reqBody != nil {
+			body = *reqBody
+		}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, errors.Wrap(err, "failed to iterate detailed report"))
+				return
+			}
+			page, err := c.SearchDetailedReportPage(ctx, workspaceID, &body)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if page.DetailedReport != nil {
+				for i := range *page.DetailedReport {
+					if !yield(&(*page.DetailedReport)[i], nil) {
+						return
+					}
+				}
+			}
+			if !page.HasNext() {
+				return
+			}
+			body.FirstID = page.NextID
+			body.FirstRowNumber = page.NextRowNumber
+			body.FirstTimestamp = page.NextTimestamp
+		}
+	}
+}
+
+// SearchAllDetailedReport returns time entries for detailed report by fetching every page.
+func (c *APIClient) SearchAllDetailedReport(ctx context.Context, workspaceID int, reqBody *SearchDetailedReportRequestBody) (*DetailedReport, error) {
+	detailedReport := DetailedReport{}
+	for row, err := range c.AllDetailedReportRows(ctx, workspaceID, reqBody) {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to search all detailed report")
+		}
+		detailedReport = append(detailedReport, *row)
+	}
+	return &detailedReport, nil
+}
@@ -2,8 +2,10 @@ package reports
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
 	"strconv"
@@ -124,14 +126,10 @@ func TestSearchDetailedReport(t *testing.T) {
 				err            error
 			}{
 				detailedReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"At least one parameter must be set\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"37"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "At least one parameter must be set",
+					Body:       "\"At least one parameter must be set\"\n",
 				},
 			},
 		},
@@ -149,13 +147,10 @@ func TestSearchDetailedReport(t *testing.T) {
 				err            error
 			}{
 				detailedReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -173,14 +168,10 @@ func TestSearchDetailedReport(t *testing.T) {
 				err            error
 			}{
 				detailedReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -199,10 +190,10 @@ func TestSearchDetailedReport(t *testing.T) {
 				internal.Errorf(t, detailedReport, tt.out.detailedReport)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -254,3 +245,176 @@ func TestSearchDetailedReportRequestBody(t *testing.T) {
 		})
 	}
 }
+
+// newPaginatedDetailedReportServer returns a mock server which serves rows 1 to 5, 2 per page.
+// Each row has a time entry whose ID is 100 times its row number, and the cursor of the next page is given by headers.
+func newPaginatedDetailedReportServer(t *testing.T, requestBodies *[]SearchDetailedReportRequestBody) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody SearchDetailedReportRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Fatal(err.Error())
+		}
+		*requestBodies = append(*requestBodies, reqBody)
+
+		first := 1
+		if reqBody.FirstRowNumber != nil {
+			first = *reqBody.FirstRowNumber
+		}
+		detailedReport := DetailedReport{}
+		for rowNumber := first; rowNumber < first+2 && rowNumber <= 5; rowNumber++ {
+			detailedReport = append(detailedReport, DetailedReportRow{
+				RowNumber:   track.Ptr(rowNumber),
+				TimeEntries: []*timeEntry{{ID: track.Ptr(100 * rowNumber)}},
+			})
+		}
+		if next := first + 2; next <= 5 {
+			w.Header().Set("X-Next-ID", strconv.Itoa(100*next))
+			w.Header().Set("X-Next-Row-Number", strconv.Itoa(next))
+			w.Header().Set("X-Next-Timestamp", strconv.Itoa(1577836800+next))
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(detailedReport)
+	}))
+}
+
+func TestSearchDetailedReportPage(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *SearchDetailedReportRequestBody
+		out  struct {
+			rowNumbers    []int
+			nextID        *int
+			nextRowNumber *int
+			nextTimestamp *int
+			hasNext       bool
+		}
+	}{
+		{
+			name: "first page",
+			in:   &SearchDetailedReportRequestBody{},
+			out: struct {
+				rowNumbers    []int
+				nextID        *int
+				nextRowNumber *int
+				nextTimestamp *int
+				hasNext       bool
+			}{
+				rowNumbers:    []int{1, 2},
+				nextID:        track.Ptr(300),
+				nextRowNumber: track.Ptr(3),
+				nextTimestamp: track.Ptr(1577836803),
+				hasNext:       true,
+			},
+		},
+		{
+			name: "last page",
+			in:   &SearchDetailedReportRequestBody{FirstID: track.Ptr(500), FirstRowNumber: track.Ptr(5), FirstTimestamp: track.Ptr(1577836805)},
+			out: struct {
+				rowNumbers    []int
+				nextID        *int
+				nextRowNumber *int
+				nextTimestamp *int
+				hasNext       bool
+			}{
+				rowNumbers:    []int{5},
+				nextID:        nil,
+				nextRowNumber: nil,
+				nextTimestamp: nil,
+				hasNext:       false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestBodies []SearchDetailedReportRequestBody
+			mockServer := newPaginatedDetailedReportServer(t, &requestBodies)
+			defer mockServer.Close()
+
+			workspaceID := 1234567
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			page, err := apiClient.SearchDetailedReportPage(context.Background(), workspaceID, tt.in)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			var rowNumbers []int
+			for _, row := range *page.DetailedReport {
+				rowNumbers = append(rowNumbers, *row.RowNumber)
+			}
+			if !reflect.DeepEqual(rowNumbers, tt.out.rowNumbers) {
+				internal.Errorf(t, rowNumbers, tt.out.rowNumbers)
+			}
+			if !reflect.DeepEqual(page.NextID, tt.out.nextID) {
+				internal.Errorf(t, page.NextID, tt.out.nextID)
+			}
+			if !reflect.DeepEqual(page.NextRowNumber, tt.out.nextRowNumber) {
+				internal.Errorf(t, page.NextRowNumber, tt.out.nextRowNumber)
+			}
+			if !reflect.DeepEqual(page.NextTimestamp, tt.out.nextTimestamp) {
+				internal.Errorf(t, page.NextTimestamp, tt.out.nextTimestamp)
+			}
+			if page.HasNext() != tt.out.hasNext {
+				internal.Errorf(t, page.HasNext(), tt.out.hasNext)
+			}
+		})
+	}
+}
+
+func TestAllDetailedReportRows(t *testing.T) {
+	var requestBodies []SearchDetailedReportRequestBody
+	mockServer := newPaginatedDetailedReportServer(t, &requestBodies)
+	defer mockServer.Close()
+
+	workspaceID := 1234567
+	apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+
+	var rowNumbers []int
+	reqBody := &SearchDetailedReportRequestBody{StartDate: track.Ptr("2020-01-01")}
+	for row, err := range apiClient.AllDetailedReportRows(context.Background(), workspaceID, reqBody) {
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		rowNumbers = append(rowNumbers, *row.RowNumber)
+	}
+
+	wantRowNumbers := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(rowNumbers, wantRowNumbers) {
+		internal.Errorf(t, rowNumbers, wantRowNumbers)
+	}
+	wantRequestBodies := []SearchDetailedReportRequestBody{
+		{StartDate: track.Ptr("2020-01-01")},
+		{StartDate: track.Ptr("2020-01-01"), FirstID: track.Ptr(300), FirstRowNumber: track.Ptr(3), FirstTimestamp: track.Ptr(1577836803)},
+		{StartDate: track.Ptr("2020-01-01"), FirstID: track.Ptr(500), FirstRowNumber: track.Ptr(5), FirstTimestamp: track.Ptr(1577836805)},
+	}
+	if !reflect.DeepEqual(requestBodies, wantRequestBodies) {
+		internal.Errorf(t, requestBodies, wantRequestBodies)
+	}
+	if reqBody.FirstRowNumber != nil {
+		internal.Errorf(t, reqBody.FirstRowNumber, nil)
+	}
+}
+
+func TestSearchAllDetailedReport(t *testing.T) {
+	var requestBodies []SearchDetailedReportRequestBody
+	mockServer := newPaginatedDetailedReportServer(t, &requestBodies)
+	defer mockServer.Close()
+
+	workspaceID := 1234567
+	apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+	detailedReport, err := apiClient.SearchAllDetailedReport(context.Background(), workspaceID, &SearchDetailedReportRequestBody{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var timeEntryIDs []int
+	for _, row := range *detailedReport {
+		timeEntryIDs = append(timeEntryIDs, *row.TimeEntries[0].ID)
+	}
+	wantTimeEntryIDs := []int{100, 200, 300, 400, 500}
+	if !reflect.DeepEqual(timeEntryIDs, wantTimeEntryIDs) {
+		internal.Errorf(t, timeEntryIDs, wantTimeEntryIDs)
+	}
+	if len(requestBodies) != 3 {
+		internal.Errorf(t, len(requestBodies), 3)
+	}
+}
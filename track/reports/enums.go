@@ -0,0 +1,76 @@
+package reports
+
+// Grouping specifies how a summary report is grouped.
+type Grouping string
+
+const (
+	GroupingProjects Grouping = "projects"
+	GroupingClients  Grouping = "clients"
+	GroupingUsers    Grouping = "users"
+)
+
+// IsValid reports whether g is one of the defined groupings.
+func (g Grouping) IsValid() bool {
+	switch g {
+	case GroupingProjects, GroupingClients, GroupingUsers:
+		return true
+	}
+	return false
+}
+
+// SubGrouping specifies how each group of a summary report is subdivided.
+type SubGrouping string
+
+const (
+	SubGroupingTimeEntries SubGrouping = "time_entries"
+	SubGroupingTasks       SubGrouping = "tasks"
+	SubGroupingProjects    SubGrouping = "projects"
+	SubGroupingClients     SubGrouping = "clients"
+	SubGroupingUsers       SubGrouping = "users"
+)
+
+// IsValid reports whether s is one of the defined sub groupings.
+func (s SubGrouping) IsValid() bool {
+	switch s {
+	case SubGroupingTimeEntries, SubGroupingTasks, SubGroupingProjects, SubGroupingClients, SubGroupingUsers:
+		return true
+	}
+	return false
+}
+
+// OrderBy specifies the field by which the rows of a detailed report are sorted.
+type OrderBy string
+
+const (
+	OrderByDate        OrderBy = "date"
+	OrderByUser        OrderBy = "user"
+	OrderByDuration    OrderBy = "duration"
+	OrderByDescription OrderBy = "description"
+	OrderByLastUpdate  OrderBy = "last_update"
+)
+
+// IsValid reports whether o is one of the defined fields to order by.
+func (o OrderBy) IsValid() bool {
+	switch o {
+	case OrderByDate, OrderByUser, OrderByDuration, OrderByDescription, OrderByLastUpdate:
+		return true
+	}
+	return false
+}
+
+// OrderDir specifies the order in which the rows of a detailed report are sorted.
+type OrderDir string
+
+const (
+	OrderDirAsc  OrderDir = "ASC"
+	OrderDirDesc OrderDir = "DESC"
+)
+
+// IsValid reports whether o is one of the defined order directions.
+func (o OrderDir) IsValid() bool {
+	switch o {
+	case OrderDirAsc, OrderDirDesc:
+		return true
+	}
+	return false
+}
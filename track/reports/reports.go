@@ -13,6 +13,7 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
@@ -21,10 +22,14 @@ const (
 )
 
 // APIClient is a client for interacting with Toggl Reports API v3.
+// It is safe for concurrent use by multiple goroutines.
 type APIClient struct {
-	baseURL    *url.URL
-	httpClient *http.Client
-	apiToken   string
+	baseURL        *url.URL
+	httpClient     *http.Client
+	retryPolicy    *track.RetryPolicy
+	rateLimiter    *track.RateLimiter
+	skipValidation bool
+	apiToken       string
 }
 
 // NewAPIClient creates a new Toggl Reports API v3 client.
@@ -61,6 +66,46 @@ func (h *httpClientOption) apply(c *APIClient) {
 	c.httpClient = h.httpClient
 }
 
+// WithRetryPolicy returns a Option that specifies the policy to retry requests which failed temporarily.
+// Requests are not retried by default.
+func WithRetryPolicy(retryPolicy *track.RetryPolicy) Option {
+	return &retryPolicyOption{retryPolicy: retryPolicy}
+}
+
+type retryPolicyOption struct {
+	retryPolicy *track.RetryPolicy
+}
+
+func (r *retryPolicyOption) apply(c *APIClient) {
+	c.retryPolicy = r.retryPolicy
+}
+
+// WithRateLimiter returns a Option that specifies the rate limiter which every request waits for.
+// The same rate limiter can be shared by clients of toggl, reports, and webhooks using the same API token.
+func WithRateLimiter(rateLimiter *track.RateLimiter) Option {
+	return &rateLimiterOption{rateLimiter: rateLimiter}
+}
+
+type rateLimiterOption struct {
+	rateLimiter *track.RateLimiter
+}
+
+func (r *rateLimiterOption) apply(c *APIClient) {
+	c.rateLimiter = r.rateLimiter
+}
+
+// WithoutValidation returns a Option that disables the validation of request bodies and queries before they're sent.
+// Requests are validated by default so that invalid ones fail fast with a *track.ValidationError.
+func WithoutValidation() Option {
+	return withoutValidationOption{}
+}
+
+type withoutValidationOption struct{}
+
+func (withoutValidationOption) apply(c *APIClient) {
+	c.skipValidation = true
+}
+
 // withBaseURL makes client testable by configurable URL.
 func withBaseURL(baseURL string) Option {
 	return baseURLOption(baseURL)
@@ -73,28 +118,56 @@ func (b baseURLOption) apply(c *APIClient) {
 	c.baseURL = baseURL
 }
 
-func (c *APIClient) httpPost(ctx context.Context, apiSpecificPath string, reqBody, respBody any) error {
+func (c *APIClient) httpPost(ctx context.Context, apiSpecificPath string, reqBody, respBody any) (http.Header, error) {
 	req, err := c.newRequest(ctx, http.MethodPost, apiSpecificPath, reqBody)
 	if err != nil {
-		return errors.Wrap(err, "failed to create a new POST request")
+		return nil, errors.Wrap(err, "failed to create a new POST request")
 	}
 	return c.do(req, respBody)
 }
 
 func (c *APIClient) newRequest(ctx context.Context, httpMethod, apiSpecificPath string, input any) (*http.Request, error) {
-	url := c.baseURL
+	if !c.skipValidation {
+		if err := internal.Validate(input); err != nil {
+			return nil, errors.Wrap(err, "failed to validate a request")
+		}
+	}
+
+	// Copy baseURL so that concurrent requests don't share the URL.
+	url := *c.baseURL
 	url.Path = path.Join(url.Path, apiSpecificPath)
 
-	req, err := internal.NewRequest(ctx, httpMethod, url, input)
+	req, err := internal.NewRequest(ctx, httpMethod, &url, input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create a new request")
 	}
 
 	req.SetBasicAuth(c.apiToken, internal.BasicAuthPassword)
+	// Reports API uses POST only to search reports, so it's safe to retry requests.
+	internal.MarkIdempotent(req)
 
 	return req, nil
 }
 
-func (c *APIClient) do(req *http.Request, respBody any) error {
-	return internal.Do(c.httpClient, req, respBody)
+func (c *APIClient) do(req *http.Request, respBody any) (http.Header, error) {
+	return internal.Do(c.httpClient, req, respBody, c.retryPolicy, c.rateLimiter)
+}
+
+// maxPeriodYears is the longest period which can be searched at once.
+const maxPeriodYears int = 1
+
+func validatePeriod(v *internal.Validator, startDate, endDate *string) {
+	start := v.Date(startDate, "start_date")
+	end := v.Date(endDate, "end_date")
+	v.NotBefore(end, start, "end_date", "start_date")
+	if start != nil && end != nil {
+		v.Check(!end.After(start.AddDate(maxPeriodYears, 0, 0)), "end_date", "must be within a year from start_date")
+	}
+}
+
+func validateDurationRange(v *internal.Validator, minDurationSeconds, maxDurationSeconds *int) {
+	v.Check(
+		minDurationSeconds == nil || maxDurationSeconds == nil || *minDurationSeconds <= *maxDurationSeconds,
+		"max_duration_seconds", "must not be less than min_duration_seconds",
+	)
 }
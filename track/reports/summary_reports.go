@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // SummaryReport represents the properties of a summary report.
@@ -27,26 +28,34 @@ type subGroup struct {
 
 // SearchSummaryReportRequestBody represents a request body of SearchSummaryReport.
 type SearchSummaryReportRequestBody struct {
-	Audit               *audit     `json:"audit,omitempty"`
-	Billable            *bool      `json:"billable,omitempty"`
-	ClientIDs           []*int     `json:"client_ids,omitempty"`
-	Description         *string    `json:"description,omitempty"`
-	EndDate             *string    `json:"end_date,omitempty"`
-	GroupIDs            []*int     `json:"group_ids,omitempty"`
-	Grouping            *string    `json:"grouping,omitempty"`
-	IncludeTimeEntryIDs *bool      `json:"include_time_entry_ids,omitempty"`
-	MaxDurationSeconds  *int       `json:"max_duration_seconds,omitempty"`
-	MinDurationSeconds  *int       `json:"min_duration_seconds,omitempty"`
-	PostedFields        []*string  `json:"postedFields,omitempty"`
-	ProjectIDs          []*int     `json:"project_ids,omitempty"`
-	Rounding            *int       `json:"rounding,omitempty"`
-	RoundingMinutes     *int       `json:"rounding_minutes,omitempty"`
-	StartTime           *time.Time `json:"startTime,omitempty"`
-	StartDate           *string    `json:"start_date,omitempty"`
-	SubGrouping         *string    `json:"sub_grouping,omitempty"`
-	TagIDs              []*int     `json:"tag_ids,omitempty"`
-	TaskIDs             []*int     `json:"task_ids,omitempty"`
-	UserIDs             []*int     `json:"user_ids,omitempty"`
+	Audit               *audit       `json:"audit,omitempty"`
+	Billable            *bool        `json:"billable,omitempty"`
+	ClientIDs           []*int       `json:"client_ids,omitempty"`
+	Description         *string      `json:"description,omitempty"`
+	EndDate             *string      `json:"end_date,omitempty"`
+	GroupIDs            []*int       `json:"group_ids,omitempty"`
+	Grouping            *Grouping    `json:"grouping,omitempty"`
+	IncludeTimeEntryIDs *bool        `json:"include_time_entry_ids,omitempty"`
+	MaxDurationSeconds  *int         `json:"max_duration_seconds,omitempty"`
+	MinDurationSeconds  *int         `json:"min_duration_seconds,omitempty"`
+	PostedFields        []*string    `json:"postedFields,omitempty"`
+	ProjectIDs          []*int       `json:"project_ids,omitempty"`
+	Rounding            *int         `json:"rounding,omitempty"`
+	RoundingMinutes     *int         `json:"rounding_minutes,omitempty"`
+	StartTime           *time.Time   `json:"startTime,omitempty"`
+	StartDate           *string      `json:"start_date,omitempty"`
+	SubGrouping         *SubGrouping `json:"sub_grouping,omitempty"`
+	TagIDs              []*int       `json:"tag_ids,omitempty"`
+	TaskIDs             []*int       `json:"task_ids,omitempty"`
+	UserIDs             []*int       `json:"user_ids,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *SearchSummaryReportRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	validatePeriod(v, r.StartDate, r.EndDate)
+	validateDurationRange(v, r.MinDurationSeconds, r.MaxDurationSeconds)
+	return v.Err()
 }
 
 type audit struct {
@@ -67,7 +76,7 @@ type groupFilter struct {
 func (c *APIClient) SearchSummaryReport(ctx context.Context, workspaceID int, reqBody *SearchSummaryReportRequestBody) (*SummaryReport, error) {
 	var summaryReport *SummaryReport
 	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "summary/time_entries")
-	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &summaryReport); err != nil {
+	if _, err := c.httpPost(ctx, apiSpecificPath, reqBody, &summaryReport); err != nil {
 		return nil, errors.Wrap(err, "failed to search summary report")
 	}
 	return summaryReport, nil
@@ -86,11 +95,18 @@ type LoadProjectSummaryRequestBody struct {
 	StartDate *string    `json:"start_date,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *LoadProjectSummaryRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	validatePeriod(v, r.StartDate, r.EndDate)
+	return v.Err()
+}
+
 // LoadProjectSummary returns project's summary.
 func (c *APIClient) LoadProjectSummary(ctx context.Context, workspaceID, projectID int, reqBody *LoadProjectSummaryRequestBody) (*ProjectSummary, error) {
 	var projectSummary *ProjectSummary
 	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "summary")
-	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &projectSummary); err != nil {
+	if _, err := c.httpPost(ctx, apiSpecificPath, reqBody, &projectSummary); err != nil {
 		return nil, errors.Wrap(err, "failed to load project summary")
 	}
 	return projectSummary, nil
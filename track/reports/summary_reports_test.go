@@ -4,11 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
-	"time"
 
 	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
@@ -80,14 +81,10 @@ func TestSearchSummaryReport(t *testing.T) {
 				err           error
 			}{
 				summaryReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Maximum allowed date range is 365 days\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"41"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Maximum allowed date range is 365 days",
+					Body:       "\"Maximum allowed date range is 365 days\"\n",
 				},
 			},
 		},
@@ -105,13 +102,10 @@ func TestSearchSummaryReport(t *testing.T) {
 				err           error
 			}{
 				summaryReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -129,14 +123,10 @@ func TestSearchSummaryReport(t *testing.T) {
 				err           error
 			}{
 				summaryReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "Incorrect username and/or password\n",
-					Header: http.Header{
-						"Content-Length": []string{"35"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Incorrect username and/or password",
+					Body:       "Incorrect username and/or password\n",
 				},
 			},
 		},
@@ -155,10 +145,10 @@ func TestSearchSummaryReport(t *testing.T) {
 				internal.Errorf(t, summaryReport, tt.out.summaryReport)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -257,14 +247,10 @@ func TestLoadProjectSummary(t *testing.T) {
 				err            error
 			}{
 				projectSummary: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"end_date should be within 2006-01-01 to 2030-01-01\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"53"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "end_date should be within 2006-01-01 to 2030-01-01",
+					Body:       "\"end_date should be within 2006-01-01 to 2030-01-01\"\n",
 				},
 			},
 		},
@@ -282,13 +268,10 @@ func TestLoadProjectSummary(t *testing.T) {
 				err            error
 			}{
 				projectSummary: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -306,14 +289,10 @@ func TestLoadProjectSummary(t *testing.T) {
 				err            error
 			}{
 				projectSummary: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "Incorrect username and/or password\n",
-					Header: http.Header{
-						"Content-Length": []string{"35"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Incorrect username and/or password",
+					Body:       "Incorrect username and/or password\n",
 				},
 			},
 		},
@@ -333,10 +312,10 @@ func TestLoadProjectSummary(t *testing.T) {
 				internal.Errorf(t, projectSummary, tt.out.projectSummary)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -373,3 +352,65 @@ func TestLoadProjectSummaryRequestBody(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchSummaryReportWithInvalidGrouping(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request with invalid grouping was sent")
+	}))
+	defer mockServer.Close()
+
+	apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+	reqBody := &SearchSummaryReportRequestBody{Grouping: track.Ptr(GroupingProjects), SubGrouping: track.Ptr(SubGrouping("task"))}
+	_, err := apiClient.SearchSummaryReport(context.Background(), 1234567, reqBody)
+	if err == nil || !strings.Contains(err.Error(), "sub_grouping: \"task\" is not a valid value") {
+		internal.Errorf(t, err, "sub_grouping: \"task\" is not a valid value")
+	}
+}
+
+func TestSearchSummaryReportRequestBodyValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *SearchSummaryReportRequestBody
+		out  string
+	}{
+		{
+			name: "valid",
+			in:   &SearchSummaryReportRequestBody{StartDate: track.Ptr("2023-01-01"), EndDate: track.Ptr("2024-01-01")},
+			out:  "",
+		},
+		{
+			name: "start_date not in YYYY-MM-DD",
+			in:   &SearchSummaryReportRequestBody{StartDate: track.Ptr("2023/01/01")},
+			out:  "invalid request: start_date: must be in the format of YYYY-MM-DD",
+		},
+		{
+			name: "end_date before start_date",
+			in:   &SearchSummaryReportRequestBody{StartDate: track.Ptr("2023-01-31"), EndDate: track.Ptr("2023-01-01")},
+			out:  "invalid request: end_date: must not be before start_date",
+		},
+		{
+			name: "range over a year",
+			in:   &SearchSummaryReportRequestBody{StartDate: track.Ptr("2023-01-01"), EndDate: track.Ptr("2024-01-02")},
+			out:  "invalid request: end_date: must be within a year from start_date",
+		},
+		{
+			name: "multiple fields",
+			in: &SearchSummaryReportRequestBody{
+				StartDate:          track.Ptr("2023-01-01"),
+				EndDate:            track.Ptr("2025-01-01"),
+				MinDurationSeconds: track.Ptr(60),
+				MaxDurationSeconds: track.Ptr(30),
+				Grouping:           track.Ptr(Grouping("tags")),
+			},
+			out: "invalid request: grouping: \"tags\" is not a valid value; end_date: must be within a year from start_date; max_duration_seconds: must not be less than min_duration_seconds",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			if (err == nil && tt.out != "") || (err != nil && err.Error() != tt.out) {
+				internal.Errorf(t, err, tt.out)
+			}
+		})
+	}
+}
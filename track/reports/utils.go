@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Project represents the properties of a filtered project.
@@ -31,11 +32,16 @@ type ListProjectsRequestBody struct {
 	Start      *int    `json:"start,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *ListProjectsRequestBody) Validate() error {
+	return internal.NewValidator(r).Err()
+}
+
 // ListProjects returns filtered projects from a workspace.
 func (c *APIClient) ListProjects(ctx context.Context, workspaceID int, reqBody *ListProjectsRequestBody) ([]*Project, error) {
 	var projects []*Project
 	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "filters/projects")
-	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &projects); err != nil {
+	if _, err := c.httpPost(ctx, apiSpecificPath, reqBody, &projects); err != nil {
 		return nil, errors.Wrap(err, "failed to list projects")
 	}
 	return projects, nil
@@ -8,7 +8,6 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
-	"time"
 
 	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
@@ -76,14 +75,10 @@ func TestListProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -101,13 +96,10 @@ func TestListProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -125,14 +117,10 @@ func TestListProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "Incorrect username and/or password\n",
-					Header: http.Header{
-						"Content-Length": []string{"35"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Incorrect username and/or password",
+					Body:       "Incorrect username and/or password\n",
 				},
 			},
 		},
@@ -151,10 +139,10 @@ func TestListProjects(t *testing.T) {
 				internal.Errorf(t, projects, tt.out.projects)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
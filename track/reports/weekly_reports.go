@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // WeeklyReport represents the properties of a weekly report.
@@ -36,11 +37,19 @@ type SearchWeeklyReportRequestBody struct {
 	UserIDs            []*int     `json:"user_ids,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *SearchWeeklyReportRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	validatePeriod(v, r.StartDate, r.EndDate)
+	validateDurationRange(v, r.MinDurationSeconds, r.MaxDurationSeconds)
+	return v.Err()
+}
+
 // SearchWeeklyReport returns time entries for weekly report.
 func (c *APIClient) SearchWeeklyReport(ctx context.Context, workspaceID int, reqBody *SearchWeeklyReportRequestBody) (*WeeklyReport, error) {
 	var weeklyReport *WeeklyReport
 	apiSpecificPath := path.Join(reportsPath, strconv.Itoa(workspaceID), "weekly/time_entries")
-	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &weeklyReport); err != nil {
+	if _, err := c.httpPost(ctx, apiSpecificPath, reqBody, &weeklyReport); err != nil {
 		return nil, errors.Wrap(err, "failed to search weekly report")
 	}
 	return weeklyReport, nil
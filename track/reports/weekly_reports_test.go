@@ -8,7 +8,6 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
-	"time"
 
 	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
@@ -84,14 +83,10 @@ func TestSearchWeeklyReport(t *testing.T) {
 				err          error
 			}{
 				weeklyReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"At least one parameter must be set\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"37"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "At least one parameter must be set",
+					Body:       "\"At least one parameter must be set\"\n",
 				},
 			},
 		},
@@ -109,13 +104,10 @@ func TestSearchWeeklyReport(t *testing.T) {
 				err          error
 			}{
 				weeklyReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -133,14 +125,10 @@ func TestSearchWeeklyReport(t *testing.T) {
 				err          error
 			}{
 				weeklyReport: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -159,10 +147,10 @@ func TestSearchWeeklyReport(t *testing.T) {
 				internal.Errorf(t, weeklyReport, tt.out.weeklyReport)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
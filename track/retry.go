@@ -0,0 +1,32 @@
+package track
+
+import "time"
+
+// RetryPolicy represents a policy to retry requests which failed temporarily,
+// namely those answered with 429 Too Many Requests or 503 Service Unavailable.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	// A value less than 2 disables retries.
+	MaxAttempts int
+
+	// MinBackoff is the base interval before the first retry.
+	// The interval is doubled on every retry and then randomized by jitter.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the interval computed from MinBackoff.
+	// The value of Retry-After header is honored even if it exceeds MaxBackoff.
+	MaxBackoff time.Duration
+
+	// RetryNonIdempotent allows to retry requests which are not idempotent, such as POST.
+	// Such requests are never retried by default since they may have been processed by the server.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy which is suitable for most use cases.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
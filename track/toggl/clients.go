@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Client represents the properties of a client.
@@ -17,13 +19,32 @@ type Client struct {
 	At              *time.Time `json:"at,omitempty"`
 	ServerDeletedAt *time.Time `json:"server_deleted_at,omitempty"`
 	Archived        *bool      `json:"archived,omitempty"`
+	Notes           *string    `json:"notes,omitempty"`
+	CreatorID       *int       `json:"creator_id,omitempty"`
 }
 
-// GetClients lists clients from workspace.
+// GetClientsQuery represents the additional parameters of GetClientsWithQuery.
+// Status is one of active, archived, and both, and only active clients are returned by default.
+type GetClientsQuery struct {
+	Status *ClientStatus `url:"status,omitempty"`
+	Name   *string       `url:"name,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetClientsQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
+// GetClients lists active clients from workspace.
 func (c *APIClient) GetClients(ctx context.Context, workspaceID int) ([]*Client, error) {
+	return c.GetClientsWithQuery(ctx, workspaceID, nil)
+}
+
+// GetClientsWithQuery lists clients from workspace filtered by the query.
+func (c *APIClient) GetClientsWithQuery(ctx context.Context, workspaceID int, query *GetClientsQuery) ([]*Client, error) {
 	var clients []*Client
 	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients")
-	if err := c.httpGet(ctx, apiSpecificPath, nil, &clients); err != nil {
+	if err := c.httpGet(ctx, apiSpecificPath, query, &clients); err != nil {
 		return nil, errors.Wrap(err, "failed to get clients")
 	}
 	return clients, nil
@@ -41,9 +62,17 @@ func (c *APIClient) GetClient(ctx context.Context, workspaceID, clientID int) (*
 
 // CreateClientRequestBody represents a request body of CreateClient.
 type CreateClientRequestBody struct {
-	ID   *int    `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
-	WID  *int    `json:"wid,omitempty"`
+	ID    *int    `json:"id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Notes *string `json:"notes,omitempty"`
+	WID   *int    `json:"wid,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateClientRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
 }
 
 // CreateClient creates workspace client.
@@ -58,9 +87,17 @@ func (c *APIClient) CreateClient(ctx context.Context, workspaceID int, reqBody *
 
 // UpdateClientRequestBody represents a request body of UpdateClient.
 type UpdateClientRequestBody struct {
-	ID   *int    `json:"id,omitempty"`
-	Name *string `json:"name,omitempty"`
-	WID  *int    `json:"wid,omitempty"`
+	ID    *int                   `json:"id,omitempty"`
+	Name  *string                `json:"name,omitempty"`
+	Notes track.Nullable[string] `json:"notes,omitempty"`
+	WID   *int                   `json:"wid,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateClientRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
 }
 
 // UpdateClient updates workspace client.
@@ -73,6 +110,38 @@ func (c *APIClient) UpdateClient(ctx context.Context, workspaceID, clientID int,
 	return client, nil
 }
 
+// ArchiveClient archives workspace client and its projects, and returns the IDs of the archived projects.
+func (c *APIClient) ArchiveClient(ctx context.Context, workspaceID, clientID int) ([]int, error) {
+	var projectIDs []int
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients", strconv.Itoa(clientID), "archive")
+	if err := c.httpPost(ctx, apiSpecificPath, nil, &projectIDs); err != nil {
+		return nil, errors.Wrap(err, "failed to archive client")
+	}
+	return projectIDs, nil
+}
+
+// RestoreClientRequestBody represents a request body of RestoreClient.
+// Only the projects in Projects are restored with the client unless RestoreAllProjects is true.
+type RestoreClientRequestBody struct {
+	Projects           []*int `json:"projects,omitempty"`
+	RestoreAllProjects *bool  `json:"restore_all_projects,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *RestoreClientRequestBody) Validate() error {
+	return internal.NewValidator(r).Err()
+}
+
+// RestoreClient restores archived workspace client.
+func (c *APIClient) RestoreClient(ctx context.Context, workspaceID, clientID int, reqBody *RestoreClientRequestBody) (*Client, error) {
+	var client *Client
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients", strconv.Itoa(clientID), "restore")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &client); err != nil {
+		return nil, errors.Wrap(err, "failed to restore client")
+	}
+	return client, nil
+}
+
 // DeleteClient deletes workspace client.
 func (c *APIClient) DeleteClient(ctx context.Context, workspaceID, clientID int) error {
 	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients", strconv.Itoa(clientID))
@@ -70,14 +70,10 @@ func TestGetClients(t *testing.T) {
 				err     error
 			}{
 				clients: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid workspace_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid workspace_id",
+					Body:       "\"Missing or invalid workspace_id\"\n",
 				},
 			},
 		},
@@ -95,13 +91,10 @@ func TestGetClients(t *testing.T) {
 				err     error
 			}{
 				clients: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -119,13 +112,10 @@ func TestGetClients(t *testing.T) {
 				err     error
 			}{
 				clients: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -144,10 +134,10 @@ func TestGetClients(t *testing.T) {
 				internal.Errorf(t, clients, tt.out.clients)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -158,6 +148,45 @@ func TestGetClients(t *testing.T) {
 	}
 }
 
+func TestGetClientsQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetClientsQuery
+		out  string
+	}{
+		{
+			name: "GetClientsQuery is nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "status=archived",
+			in:   &GetClientsQuery{Status: track.Ptr(ClientStatusArchived)},
+			out:  "status=archived",
+		},
+		{
+			name: "status=both&name=test",
+			in:   &GetClientsQuery{Status: track.Ptr(ClientStatusBoth), Name: track.Ptr("test")},
+			out:  "name=test&status=both",
+		},
+		{
+			name: "GetClientsQuery is empty",
+			in:   &GetClientsQuery{},
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertQuery(t, tt.out)
+			defer mockServer.Close()
+
+			workspaceID := 1234567
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.GetClientsWithQuery(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
+
 func TestGetClient(t *testing.T) {
 	tests := []struct {
 		name string
@@ -184,11 +213,13 @@ func TestGetClient(t *testing.T) {
 				err    error
 			}{
 				client: &Client{
-					ID:       track.Ptr(12345678),
-					WID:      track.Ptr(2345678),
-					Name:     track.Ptr("test client"),
-					At:       track.Ptr(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.FixedZone("", 0))),
-					Archived: track.Ptr(false),
+					ID:        track.Ptr(12345678),
+					WID:       track.Ptr(2345678),
+					Name:      track.Ptr("test client"),
+					At:        track.Ptr(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.FixedZone("", 0))),
+					Archived:  track.Ptr(false),
+					Notes:     track.Ptr("Billed monthly"),
+					CreatorID: track.Ptr(3456789),
 				},
 				err: nil,
 			},
@@ -207,13 +238,10 @@ func TestGetClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -231,13 +259,10 @@ func TestGetClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -255,14 +280,10 @@ func TestGetClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"No client with ID 0 was found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"32"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "No client with ID 0 was found",
+					Body:       "\"No client with ID 0 was found\"\n",
 				},
 			},
 		},
@@ -282,10 +303,10 @@ func TestGetClient(t *testing.T) {
 				internal.Errorf(t, client, tt.out.client)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -345,14 +366,10 @@ func TestCreateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -370,13 +387,10 @@ func TestCreateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -394,13 +408,10 @@ func TestCreateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -413,16 +424,16 @@ func TestCreateClient(t *testing.T) {
 			defer mockServer.Close()
 
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
-			client, err := apiClient.CreateClient(context.Background(), workspaceID, &CreateClientRequestBody{})
+			client, err := apiClient.CreateClient(context.Background(), workspaceID, &CreateClientRequestBody{Name: track.Ptr("client")})
 
 			if !reflect.DeepEqual(client, tt.out.client) {
 				internal.Errorf(t, client, tt.out.client)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -454,6 +465,14 @@ func TestCreateClientRequestBody(t *testing.T) {
 			},
 			out: "{\"name\":\"MyClient\",\"wid\":1234567}",
 		},
+		{
+			name: "strings",
+			in: &CreateClientRequestBody{
+				Name:  track.Ptr("MyClient"),
+				Notes: track.Ptr("Billed monthly"),
+			},
+			out: "{\"name\":\"MyClient\",\"notes\":\"Billed monthly\"}",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -515,14 +534,10 @@ func TestUpdateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -540,13 +555,10 @@ func TestUpdateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -564,13 +576,10 @@ func TestUpdateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -588,14 +597,10 @@ func TestUpdateClient(t *testing.T) {
 				err    error
 			}{
 				client: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Client doesn't exist in the workspace.\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"41"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Client doesn't exist in the workspace.",
+					Body:       "\"Client doesn't exist in the workspace.\"\n",
 				},
 			},
 		},
@@ -615,10 +620,10 @@ func TestUpdateClient(t *testing.T) {
 				internal.Errorf(t, client, tt.out.client)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -701,14 +706,10 @@ func TestDeleteClient(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"We're expecting an integer as part of the url for client_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"62"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "We're expecting an integer as part of the url for client_id",
+					Body:       "\"We're expecting an integer as part of the url for client_id\"\n",
 				},
 			},
 		},
@@ -724,13 +725,10 @@ func TestDeleteClient(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -746,13 +744,10 @@ func TestDeleteClient(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -768,14 +763,10 @@ func TestDeleteClient(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"No client with ID 12345678 was found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"39"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "No client with ID 12345678 was found",
+					Body:       "\"No client with ID 12345678 was found\"\n",
 				},
 			},
 		},
@@ -791,10 +782,323 @@ func TestDeleteClient(t *testing.T) {
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
 			err := apiClient.DeleteClient(context.Background(), workspaceID, clientID)
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveClient(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			projectIDs []int
+			err        error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/clients/archive_client_200_ok.json",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: []int{23456789, 34567890},
+				err:        nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/clients/archive_client_400_bad_request.json",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Client is already archived",
+					Body:       "\"Client is already archived\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/clients/archive_client_401_unauthorized",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "402 Payment Required",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusPaymentRequired,
+				testdataFile: "testdata/clients/archive_client_402_payment_required.json",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: nil,
+				err: &track.APIError{
+					StatusCode: 402,
+					Message:    "Archiving clients is available only on paid plans",
+					Body:       "\"Archiving clients is available only on paid plans\"\n",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/clients/archive_client_403_forbidden",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/clients/archive_client_404_not_found.json",
+			},
+			out: struct {
+				projectIDs []int
+				err        error
+			}{
+				projectIDs: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			clientID := 12345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients", strconv.Itoa(clientID), "archive")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			projectIDs, err := apiClient.ArchiveClient(context.Background(), workspaceID, clientID)
+
+			if !reflect.DeepEqual(projectIDs, tt.out.projectIDs) {
+				internal.Errorf(t, projectIDs, tt.out.projectIDs)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestRestoreClient(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			client *Client
+			err    error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/clients/restore_client_200_ok.json",
+			},
+			out: struct {
+				client *Client
+				err    error
+			}{
+				client: &Client{
+					ID:       track.Ptr(12345678),
+					WID:      track.Ptr(1234567),
+					Name:     track.Ptr("test client"),
+					At:       track.Ptr(time.Date(2020, time.January, 2, 3, 4, 5, 0, time.Local)),
+					Archived: track.Ptr(false),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/clients/restore_client_400_bad_request.json",
+			},
+			out: struct {
+				client *Client
+				err    error
+			}{
+				client: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Client is not archived",
+					Body:       "\"Client is not archived\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/clients/restore_client_401_unauthorized",
+			},
+			out: struct {
+				client *Client
+				err    error
+			}{
+				client: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/clients/restore_client_403_forbidden",
+			},
+			out: struct {
+				client *Client
+				err    error
+			}{
+				client: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/clients/restore_client_404_not_found.json",
+			},
+			out: struct {
+				client *Client
+				err    error
+			}{
+				client: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			clientID := 12345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "clients", strconv.Itoa(clientID), "restore")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			client, err := apiClient.RestoreClient(context.Background(), workspaceID, clientID, &RestoreClientRequestBody{})
+
+			if !reflect.DeepEqual(client, tt.out.client) {
+				internal.Errorf(t, client, tt.out.client)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -804,3 +1108,36 @@ func TestDeleteClient(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreClientRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *RestoreClientRequestBody
+		out  string
+	}{
+		{
+			name: "slice",
+			in: &RestoreClientRequestBody{
+				Projects: []*int{track.Ptr(23456789)},
+			},
+			out: "{\"projects\":[23456789]}",
+		},
+		{
+			name: "bool",
+			in: &RestoreClientRequestBody{
+				RestoreAllProjects: track.Ptr(true),
+			},
+			out: "{\"restore_all_projects\":true}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			clientID := 12345678
+			_, _ = apiClient.RestoreClient(context.Background(), workspaceID, clientID, tt.in)
+		})
+	}
+}
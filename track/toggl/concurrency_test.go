@@ -0,0 +1,636 @@
+package toggl
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestAPIClientConcurrentUse(t *testing.T) {
+	const (
+		organizationID = 1234567
+		workspaceID    = 2345678
+		resourceID     = 3456789
+		subresourceID  = 4567890
+		parallelism    = 10
+	)
+	endpoints := []struct {
+		name   string
+		method string
+		path   string
+		call   func(ctx context.Context, c *APIClient) error
+	}{
+		{
+			name:   "GetMe",
+			method: http.MethodGet,
+			path:   "/api/v9/me",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMe(ctx)
+				return err
+			},
+		},
+		{
+			name:   "UpdateMe",
+			method: http.MethodPut,
+			path:   "/api/v9/me",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateMe(ctx, &UpdateMeRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "GetMyOrganizations",
+			method: http.MethodGet,
+			path:   "/api/v9/me/organizations",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyOrganizations(ctx)
+				return err
+			},
+		},
+		{
+			name:   "GetMyProjects",
+			method: http.MethodGet,
+			path:   "/api/v9/me/projects",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyProjects(ctx, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetMyProjectsPaginated",
+			method: http.MethodGet,
+			path:   "/api/v9/me/projects/paginated",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyProjectsPaginated(ctx, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetMyTags",
+			method: http.MethodGet,
+			path:   "/api/v9/me/tags",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyTags(ctx)
+				return err
+			},
+		},
+		{
+			name:   "GetMyClients",
+			method: http.MethodGet,
+			path:   "/api/v9/me/clients",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyClients(ctx)
+				return err
+			},
+		},
+		{
+			name:   "GetOrganization",
+			method: http.MethodGet,
+			path:   "/api/v9/organizations/1234567",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetOrganization(ctx, organizationID)
+				return err
+			},
+		},
+		{
+			name:   "GetOrganizationUsers",
+			method: http.MethodGet,
+			path:   "/api/v9/organizations/1234567/users",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetOrganizationUsers(ctx, organizationID, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetWorkspace",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetWorkspace(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "GetWorkspaceUsers",
+			method: http.MethodGet,
+			path:   "/api/v9/organizations/1234567/workspaces/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetWorkspaceUsers(ctx, organizationID, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "UpdateWorkspace",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateWorkspace(ctx, workspaceID, &UpdateWorkspaceRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "GetProjects",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/projects",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetProjects(ctx, workspaceID, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetProject",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/projects/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetProject(ctx, workspaceID, resourceID, nil)
+				return err
+			},
+		},
+		{
+			name:   "CreateProject",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/projects",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateProject(ctx, workspaceID, &CreateProjectRequestBody{Name: track.Ptr("project")})
+				return err
+			},
+		},
+		{
+			name:   "DeleteProject",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/projects/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteProject(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "GetClients",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/clients",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetClients(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "GetClient",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/clients/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetClient(ctx, workspaceID, resourceID)
+				return err
+			},
+		},
+		{
+			name:   "CreateClient",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/clients",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateClient(ctx, workspaceID, &CreateClientRequestBody{Name: track.Ptr("client")})
+				return err
+			},
+		},
+		{
+			name:   "UpdateClient",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/clients/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateClient(ctx, workspaceID, resourceID, &UpdateClientRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteClient",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/clients/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteClient(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "GetTags",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/tags",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetTags(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "CreateTag",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/tags",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateTag(ctx, workspaceID, &CreateTagRequestBody{Name: track.Ptr("tag")})
+				return err
+			},
+		},
+		{
+			name:   "UpdateTag",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/tags/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateTag(ctx, workspaceID, resourceID, &UpdateTagRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteTag",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/tags/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteTag(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "GetMyTasks",
+			method: http.MethodGet,
+			path:   "/api/v9/me/tasks",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetMyTasks(ctx, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetTasks",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/projects/3456789/tasks",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetTasks(ctx, workspaceID, resourceID, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetTask",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/projects/3456789/tasks/4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetTask(ctx, workspaceID, resourceID, subresourceID)
+				return err
+			},
+		},
+		{
+			name:   "CreateTask",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/projects/3456789/tasks",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateTask(ctx, workspaceID, resourceID, &CreateTaskRequestBody{Name: track.Ptr("task")})
+				return err
+			},
+		},
+		{
+			name:   "UpdateTask",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/projects/3456789/tasks/4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateTask(ctx, workspaceID, resourceID, subresourceID, &UpdateTaskRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteTask",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/projects/3456789/tasks/4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteTask(ctx, workspaceID, resourceID, subresourceID)
+			},
+		},
+		{
+			name:   "GetTimeEntries",
+			method: http.MethodGet,
+			path:   "/api/v9/me/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetTimeEntries(ctx, nil)
+				return err
+			},
+		},
+		{
+			name:   "GetCurrentTimeEntry",
+			method: http.MethodGet,
+			path:   "/api/v9/me/time_entries/current",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetCurrentTimeEntry(ctx)
+				return err
+			},
+		},
+		{
+			name:   "CreateTimeEntry",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateTimeEntry(ctx, workspaceID, &CreateTimeEntryRequestBody{WorkspaceID: track.Ptr(workspaceID), CreatedWith: track.Ptr("toggl-go"), Start: track.Ptr(time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC))})
+				return err
+			},
+		},
+		{
+			name:   "UpdateTimeEntry",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/time_entries/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateTimeEntry(ctx, workspaceID, resourceID, &UpdateTimeEntryRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteTimeEntry",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/time_entries/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteTimeEntry(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "UpdateProject",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/projects/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateProject(ctx, workspaceID, resourceID, &UpdateProjectRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "PatchProjects",
+			method: http.MethodPatch,
+			path:   "/api/v9/workspaces/2345678/projects/3456789,4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.PatchProjects(ctx, workspaceID, []int{resourceID, subresourceID}, NewProjectPatch().Active(false).Operations())
+				return err
+			},
+		},
+		{
+			name:   "StartTimeEntry",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/time_entries",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.StartTimeEntry(ctx, workspaceID, nil)
+				return err
+			},
+		},
+		{
+			name:   "StopTimeEntry",
+			method: http.MethodPatch,
+			path:   "/api/v9/workspaces/2345678/time_entries/3456789/stop",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.StopTimeEntry(ctx, workspaceID, resourceID)
+				return err
+			},
+		},
+		{
+			name:   "PatchTimeEntries",
+			method: http.MethodPatch,
+			path:   "/api/v9/workspaces/2345678/time_entries/3456789,4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.PatchTimeEntries(ctx, workspaceID, []int{resourceID, subresourceID}, NewTimeEntryPatch().Billable(true).Operations())
+				return err
+			},
+		},
+		{
+			name:   "GetGroups",
+			method: http.MethodGet,
+			path:   "/api/v9/organizations/1234567/groups",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetGroups(ctx, organizationID, nil)
+				return err
+			},
+		},
+		{
+			name:   "CreateGroup",
+			method: http.MethodPost,
+			path:   "/api/v9/organizations/1234567/groups",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateGroup(ctx, organizationID, &CreateGroupRequestBody{Name: track.Ptr("group")})
+				return err
+			},
+		},
+		{
+			name:   "UpdateGroup",
+			method: http.MethodPut,
+			path:   "/api/v9/organizations/1234567/groups/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateGroup(ctx, organizationID, resourceID, &UpdateGroupRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteGroup",
+			method: http.MethodDelete,
+			path:   "/api/v9/organizations/1234567/groups/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteGroup(ctx, organizationID, resourceID)
+			},
+		},
+		{
+			name:   "AddGroupMembers",
+			method: http.MethodPatch,
+			path:   "/api/v9/organizations/1234567/groups/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.AddGroupMembers(ctx, organizationID, resourceID, []int{subresourceID})
+				return err
+			},
+		},
+		{
+			name:   "GetProjectUsers",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/project_users",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetProjectUsers(ctx, workspaceID, nil)
+				return err
+			},
+		},
+		{
+			name:   "AddProjectUser",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/project_users",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.AddProjectUser(ctx, workspaceID, &AddProjectUserRequestBody{ProjectID: track.Ptr(resourceID), UserID: track.Ptr(subresourceID)})
+				return err
+			},
+		},
+		{
+			name:   "UpdateProjectUser",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/project_users/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateProjectUser(ctx, workspaceID, resourceID, &UpdateProjectUserRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "PatchProjectUsers",
+			method: http.MethodPatch,
+			path:   "/api/v9/workspaces/2345678/project_users/3456789,4567890",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.PatchProjectUsers(ctx, workspaceID, []int{resourceID, subresourceID}, NewProjectUserPatch().Manager(true).Operations())
+				return err
+			},
+		},
+		{
+			name:   "DeleteProjectUser",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/project_users/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteProjectUser(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "InviteOrganizationUsers",
+			method: http.MethodPost,
+			path:   "/api/v9/organizations/1234567/invitation",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.InviteOrganizationUsers(ctx, organizationID, &InviteOrganizationUsersRequestBody{Emails: []*string{track.Ptr("user@example.com")}})
+				return err
+			},
+		},
+		{
+			name:   "UpdateOrganizationUser",
+			method: http.MethodPut,
+			path:   "/api/v9/organizations/1234567/users/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.UpdateOrganizationUser(ctx, organizationID, resourceID, &UpdateOrganizationUserRequestBody{})
+			},
+		},
+		{
+			name:   "RemoveOrganizationUsers",
+			method: http.MethodPatch,
+			path:   "/api/v9/organizations/1234567/users",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.RemoveOrganizationUsers(ctx, organizationID, []int{resourceID})
+			},
+		},
+		{
+			name:   "UpdateWorkspaceUser",
+			method: http.MethodPut,
+			path:   "/api/v9/workspaces/2345678/workspace_users/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateWorkspaceUser(ctx, workspaceID, resourceID, &UpdateWorkspaceUserRequestBody{})
+				return err
+			},
+		},
+		{
+			name:   "DeleteWorkspaceUser",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/workspace_users/3456789",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteWorkspaceUser(ctx, workspaceID, resourceID)
+			},
+		},
+		{
+			name:   "CreateOrganization",
+			method: http.MethodPost,
+			path:   "/api/v9/organizations",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateOrganization(ctx, &CreateOrganizationRequestBody{Name: track.Ptr("organization")})
+				return err
+			},
+		},
+		{
+			name:   "UpdateOrganization",
+			method: http.MethodPut,
+			path:   "/api/v9/organizations/1234567",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.UpdateOrganization(ctx, organizationID, &UpdateOrganizationRequestBody{})
+			},
+		},
+		{
+			name:   "CreateWorkspace",
+			method: http.MethodPost,
+			path:   "/api/v9/organizations/1234567/workspaces",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateWorkspace(ctx, organizationID, &CreateWorkspaceRequestBody{Name: track.Ptr("workspace")})
+				return err
+			},
+		},
+		{
+			name:   "GetWorkspaceLogo",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/logo",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetWorkspaceLogo(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "UploadWorkspaceLogo",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/logo",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UploadWorkspaceLogo(ctx, workspaceID, "logo.png", strings.NewReader("PNG"))
+				return err
+			},
+		},
+		{
+			name:   "DeleteWorkspaceLogo",
+			method: http.MethodDelete,
+			path:   "/api/v9/workspaces/2345678/logo",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.DeleteWorkspaceLogo(ctx, workspaceID)
+			},
+		},
+		{
+			name:   "GetWorkspacePreferences",
+			method: http.MethodGet,
+			path:   "/api/v9/workspaces/2345678/preferences",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetWorkspacePreferences(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "UpdateWorkspacePreferences",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/preferences",
+			call: func(ctx context.Context, c *APIClient) error {
+				return c.UpdateWorkspacePreferences(ctx, workspaceID, &UpdateWorkspacePreferencesRequestBody{})
+			},
+		},
+		{
+			name:   "ArchiveClient",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/clients/3456789/archive",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.ArchiveClient(ctx, workspaceID, resourceID)
+				return err
+			},
+		},
+		{
+			name:   "RestoreClient",
+			method: http.MethodPost,
+			path:   "/api/v9/workspaces/2345678/clients/3456789/restore",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.RestoreClient(ctx, workspaceID, resourceID, &RestoreClientRequestBody{})
+				return err
+			},
+		},
+	}
+
+	mockServer, counts := internal.NewMockServerToCountRequests(t)
+	defer mockServer.Close()
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+	want := make(map[string]int)
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		want[endpoint.method+" "+endpoint.path] += parallelism
+		for i := 0; i < parallelism; i++ {
+			wg.Add(1)
+			go func(name string, call func(ctx context.Context, c *APIClient) error) {
+				defer wg.Done()
+				if err := call(context.Background(), apiClient); err != nil {
+					t.Errorf("%s: %v", name, err)
+				}
+			}(endpoint.name, endpoint.call)
+		}
+	}
+	wg.Wait()
+
+	if got := counts(); !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+}
@@ -0,0 +1,126 @@
+package toggl
+
+// TagAction specifies how the tags of a time entry are updated.
+type TagAction string
+
+const (
+	TagActionAdd    TagAction = "add"
+	TagActionDelete TagAction = "delete"
+)
+
+// IsValid reports whether t is one of the defined tag actions.
+func (t TagAction) IsValid() bool {
+	switch t {
+	case TagActionAdd, TagActionDelete:
+		return true
+	}
+	return false
+}
+
+// SortField specifies the field by which projects and tasks are sorted.
+type SortField string
+
+const (
+	SortFieldName      SortField = "name"
+	SortFieldCreatedAt SortField = "created_at"
+)
+
+// IsValid reports whether s is one of the defined sort fields.
+func (s SortField) IsValid() bool {
+	switch s {
+	case SortFieldName, SortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+// SortOrder specifies the order in which projects and tasks are sorted.
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+// IsValid reports whether s is one of the defined sort orders.
+func (s SortOrder) IsValid() bool {
+	switch s {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+// SortDir specifies the order in which organization users are sorted.
+type SortDir string
+
+const (
+	SortDirAsc  SortDir = "asc"
+	SortDirDesc SortDir = "desc"
+)
+
+// IsValid reports whether s is one of the defined sort directions.
+func (s SortDir) IsValid() bool {
+	switch s {
+	case SortDirAsc, SortDirDesc:
+		return true
+	}
+	return false
+}
+
+// ActiveStatus filters organization users by their status.
+type ActiveStatus string
+
+const (
+	ActiveStatusActive   ActiveStatus = "active"
+	ActiveStatusInactive ActiveStatus = "inactive"
+	ActiveStatusInvited  ActiveStatus = "invited"
+)
+
+// IsValid reports whether a is one of the defined active statuses.
+func (a ActiveStatus) IsValid() bool {
+	switch a {
+	case ActiveStatusActive, ActiveStatusInactive, ActiveStatusInvited:
+		return true
+	}
+	return false
+}
+
+// ClientStatus filters clients by whether they are archived.
+type ClientStatus string
+
+const (
+	ClientStatusActive   ClientStatus = "active"
+	ClientStatusArchived ClientStatus = "archived"
+	ClientStatusBoth     ClientStatus = "both"
+)
+
+// IsValid reports whether c is one of the defined client statuses.
+func (c ClientStatus) IsValid() bool {
+	switch c {
+	case ClientStatusActive, ClientStatusArchived, ClientStatusBoth:
+		return true
+	}
+	return false
+}
+
+// RateChangeMode specifies which time entries are affected by a change of a rate or a labor cost.
+type RateChangeMode string
+
+const (
+	// RateChangeModeStartToday applies the new rate to time entries from today.
+	RateChangeModeStartToday RateChangeMode = "start-today"
+	// RateChangeModeOverrideCurrent applies the new rate to time entries in the current period.
+	RateChangeModeOverrideCurrent RateChangeMode = "override-current"
+	// RateChangeModeOverrideAll applies the new rate to all time entries.
+	RateChangeModeOverrideAll RateChangeMode = "override-all"
+)
+
+// IsValid reports whether r is one of the defined rate change modes.
+func (r RateChangeMode) IsValid() bool {
+	switch r {
+	case RateChangeModeStartToday, RateChangeModeOverrideCurrent, RateChangeModeOverrideAll:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package toggl
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+// Group represents the properties of a group in an organization.
+type Group struct {
+	GroupID    *int         `json:"group_id,omitempty"`
+	Name       *string      `json:"name,omitempty"`
+	At         *time.Time   `json:"at,omitempty"`
+	Workspaces []*int       `json:"workspaces,omitempty"`
+	Users      []*GroupUser `json:"users,omitempty"`
+}
+
+// GroupUser represents the properties of a user who belongs to a group.
+type GroupUser struct {
+	UserID    *int    `json:"user_id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	AvatarURL *string `json:"avatar_url,omitempty"`
+	Joined    *bool   `json:"joined,omitempty"`
+	Inactive  *bool   `json:"inactive,omitempty"`
+}
+
+// GetGroupsQuery represents the additional parameters of GetGroups.
+type GetGroupsQuery struct {
+	Name      *string `url:"name,omitempty"`
+	Workspace *int    `url:"workspace,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetGroupsQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
+// GetGroups returns list of groups in an organization.
+func (c *APIClient) GetGroups(ctx context.Context, organizationID int, query *GetGroupsQuery) ([]*Group, error) {
+	var groups []*Group
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups")
+	if err := c.httpGet(ctx, apiSpecificPath, query, &groups); err != nil {
+		return nil, errors.Wrap(err, "failed to get groups")
+	}
+	return groups, nil
+}
+
+// CreateGroupRequestBody represents a request body of CreateGroup.
+type CreateGroupRequestBody struct {
+	Name       *string `json:"name,omitempty"`
+	Users      []*int  `json:"users,omitempty"`
+	Workspaces []*int  `json:"workspaces,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateGroupRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
+// CreateGroup creates a group in an organization.
+func (c *APIClient) CreateGroup(ctx context.Context, organizationID int, reqBody *CreateGroupRequestBody) (*Group, error) {
+	var group *Group
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &group); err != nil {
+		return nil, errors.Wrap(err, "failed to create group")
+	}
+	return group, nil
+}
+
+// UpdateGroupRequestBody represents a request body of UpdateGroup.
+// Users and Workspaces replace the current members of the group.
+type UpdateGroupRequestBody struct {
+	Name       *string `json:"name,omitempty"`
+	Users      []*int  `json:"users,omitempty"`
+	Workspaces []*int  `json:"workspaces,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateGroupRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
+}
+
+// UpdateGroup updates a group in an organization.
+func (c *APIClient) UpdateGroup(ctx context.Context, organizationID, groupID int, reqBody *UpdateGroupRequestBody) (*Group, error) {
+	var group *Group
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &group); err != nil {
+		return nil, errors.Wrap(err, "failed to update group")
+	}
+	return group, nil
+}
+
+// DeleteGroup deletes a group from an organization.
+func (c *APIClient) DeleteGroup(ctx context.Context, organizationID, groupID int) error {
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete group")
+	}
+	return nil
+}
+
+// AddGroupMembers adds users to a group without changing the other members.
+// If userIDs is empty, it returns nil without sending a request.
+func (c *APIClient) AddGroupMembers(ctx context.Context, organizationID, groupID int, userIDs []int) (*Group, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	operations := []*PatchOperation{{Op: PatchOpAdd, Path: "/users", Value: userIDs}}
+	group, err := c.patchGroup(ctx, organizationID, groupID, operations)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add group members")
+	}
+	return group, nil
+}
+
+// RemoveGroupMembers removes users from a group without changing the other members.
+// If userIDs is empty, it returns nil without sending a request.
+func (c *APIClient) RemoveGroupMembers(ctx context.Context, organizationID, groupID int, userIDs []int) (*Group, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	operations := []*PatchOperation{{Op: PatchOpRemove, Path: "/users", Value: userIDs}}
+	group, err := c.patchGroup(ctx, organizationID, groupID, operations)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to remove group members")
+	}
+	return group, nil
+}
+
+func (c *APIClient) patchGroup(ctx context.Context, organizationID, groupID int, operations []*PatchOperation) (*Group, error) {
+	var group *Group
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+	if err := c.httpPatch(ctx, apiSpecificPath, operations, &group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
@@ -0,0 +1,1076 @@
+package toggl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestGetGroups(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			groups []*Group
+			err    error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/get_groups_200_ok.json",
+			},
+			out: struct {
+				groups []*Group
+				err    error
+			}{
+				groups: []*Group{
+					{
+						GroupID:    track.Ptr(4567890),
+						Name:       track.Ptr("MyGroup"),
+						At:         track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+						Workspaces: []*int{track.Ptr(2345678)},
+						Users: []*GroupUser{
+							{
+								UserID:    track.Ptr(3456789),
+								Name:      track.Ptr("Toggl Track"),
+								AvatarURL: track.Ptr(""),
+								Joined:    track.Ptr(true),
+								Inactive:  track.Ptr(false),
+							},
+						},
+					},
+					{
+						GroupID: track.Ptr(5678901),
+						Name:    track.Ptr("AnotherGroup"),
+						At:      track.Ptr(time.Date(2022, time.February, 3, 4, 5, 6, 0, time.Local)),
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/get_groups_400_bad_request.json",
+			},
+			out: struct {
+				groups []*Group
+				err    error
+			}{
+				groups: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Missing or invalid organization_id",
+					Body:       "\"Missing or invalid organization_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/get_groups_401_unauthorized",
+			},
+			out: struct {
+				groups []*Group
+				err    error
+			}{
+				groups: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/get_groups_403_forbidden",
+			},
+			out: struct {
+				groups []*Group
+				err    error
+			}{
+				groups: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			groups, err := apiClient.GetGroups(context.Background(), organizationID, &GetGroupsQuery{})
+
+			if !reflect.DeepEqual(groups, tt.out.groups) {
+				internal.Errorf(t, groups, tt.out.groups)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetGroupsQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetGroupsQuery
+		out  string
+	}{
+		{
+			name: "GetGroupsQuery is nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "name=MyGroup",
+			in:   &GetGroupsQuery{Name: track.Ptr("MyGroup")},
+			out:  "name=MyGroup",
+		},
+		{
+			name: "name=MyGroup&workspace=2345678",
+			in:   &GetGroupsQuery{Name: track.Ptr("MyGroup"), Workspace: track.Ptr(2345678)},
+			out:  "name=MyGroup&workspace=2345678",
+		},
+		{
+			name: "GetGroupsQuery is empty",
+			in:   &GetGroupsQuery{},
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertQuery(t, tt.out)
+			defer mockServer.Close()
+
+			organizationID := 1234567
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.GetGroups(context.Background(), organizationID, tt.in)
+		})
+	}
+}
+
+func TestCreateGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			group *Group
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/create_group_200_ok.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: &Group{
+					GroupID:    track.Ptr(4567890),
+					Name:       track.Ptr("MyGroup"),
+					At:         track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					Workspaces: []*int{track.Ptr(2345678)},
+					Users: []*GroupUser{
+						{
+							UserID:    track.Ptr(3456789),
+							Name:      track.Ptr("Toggl Track"),
+							AvatarURL: track.Ptr(""),
+							Joined:    track.Ptr(true),
+							Inactive:  track.Ptr(false),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/create_group_400_bad_request.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/create_group_401_unauthorized",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/create_group_403_forbidden",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			group, err := apiClient.CreateGroup(context.Background(), organizationID, &CreateGroupRequestBody{Name: track.Ptr("group")})
+
+			if !reflect.DeepEqual(group, tt.out.group) {
+				internal.Errorf(t, group, tt.out.group)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateGroupRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateGroupRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &CreateGroupRequestBody{
+				Name: track.Ptr("MyGroup"),
+			},
+			out: "{\"name\":\"MyGroup\"}",
+		},
+		{
+			name: "string and slices",
+			in: &CreateGroupRequestBody{
+				Name:       track.Ptr("MyGroup"),
+				Users:      []*int{track.Ptr(3456789)},
+				Workspaces: []*int{track.Ptr(2345678)},
+			},
+			out: "{\"name\":\"MyGroup\",\"users\":[3456789],\"workspaces\":[2345678]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			_, _ = apiClient.CreateGroup(context.Background(), organizationID, tt.in)
+		})
+	}
+}
+
+func TestUpdateGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			group *Group
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/update_group_200_ok.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: &Group{
+					GroupID:    track.Ptr(4567890),
+					Name:       track.Ptr("MyGroup"),
+					At:         track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					Workspaces: []*int{track.Ptr(2345678)},
+					Users: []*GroupUser{
+						{
+							UserID:    track.Ptr(3456789),
+							Name:      track.Ptr("Toggl Track"),
+							AvatarURL: track.Ptr(""),
+							Joined:    track.Ptr(true),
+							Inactive:  track.Ptr(false),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/update_group_400_bad_request.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/update_group_401_unauthorized",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/update_group_403_forbidden",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/groups/update_group_404_not_found.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			groupID := 4567890
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			group, err := apiClient.UpdateGroup(context.Background(), organizationID, groupID, &UpdateGroupRequestBody{})
+
+			if !reflect.DeepEqual(group, tt.out.group) {
+				internal.Errorf(t, group, tt.out.group)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateGroupRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateGroupRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &UpdateGroupRequestBody{
+				Name: track.Ptr("MyGroup"),
+			},
+			out: "{\"name\":\"MyGroup\"}",
+		},
+		{
+			name: "slice",
+			in: &UpdateGroupRequestBody{
+				Users: []*int{track.Ptr(3456789), track.Ptr(4567890)},
+			},
+			out: "{\"users\":[3456789,4567890]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			groupID := 4567890
+			_, _ = apiClient.UpdateGroup(context.Background(), organizationID, groupID, tt.in)
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/delete_group_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/delete_group_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "We're expecting an integer as part of the url for group_id",
+					Body:       "\"We're expecting an integer as part of the url for group_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/delete_group_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/delete_group_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/groups/delete_group_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			groupID := 4567890
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.DeleteGroup(context.Background(), organizationID, groupID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestAddGroupMembers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			group *Group
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/add_group_members_200_ok.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: &Group{
+					GroupID:    track.Ptr(4567890),
+					Name:       track.Ptr("MyGroup"),
+					At:         track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					Workspaces: []*int{track.Ptr(2345678)},
+					Users: []*GroupUser{
+						{
+							UserID:    track.Ptr(3456789),
+							Name:      track.Ptr("Toggl Track"),
+							AvatarURL: track.Ptr(""),
+							Joined:    track.Ptr(true),
+							Inactive:  track.Ptr(false),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/add_group_members_400_bad_request.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid patch operation",
+					Body:       "\"Invalid patch operation\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/add_group_members_401_unauthorized",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/add_group_members_403_forbidden",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/groups/add_group_members_404_not_found.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			groupID := 4567890
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			group, err := apiClient.AddGroupMembers(context.Background(), organizationID, groupID, []int{3456789})
+
+			if !reflect.DeepEqual(group, tt.out.group) {
+				internal.Errorf(t, group, tt.out.group)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoveGroupMembers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			group *Group
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/groups/remove_group_members_200_ok.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: &Group{
+					GroupID:    track.Ptr(4567890),
+					Name:       track.Ptr("MyGroup"),
+					At:         track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					Workspaces: []*int{track.Ptr(2345678)},
+					Users: []*GroupUser{
+						{
+							UserID:    track.Ptr(3456789),
+							Name:      track.Ptr("Toggl Track"),
+							AvatarURL: track.Ptr(""),
+							Joined:    track.Ptr(true),
+							Inactive:  track.Ptr(false),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/groups/remove_group_members_400_bad_request.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid patch operation",
+					Body:       "\"Invalid patch operation\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/groups/remove_group_members_401_unauthorized",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/groups/remove_group_members_403_forbidden",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/groups/remove_group_members_404_not_found.json",
+			},
+			out: struct {
+				group *Group
+				err   error
+			}{
+				group: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			groupID := 4567890
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "groups", strconv.Itoa(groupID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			group, err := apiClient.RemoveGroupMembers(context.Background(), organizationID, groupID, []int{3456789})
+
+			if !reflect.DeepEqual(group, tt.out.group) {
+				internal.Errorf(t, group, tt.out.group)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGroupMembersRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   func(*APIClient) error
+		out  string
+	}{
+		{
+			name: "AddGroupMembers",
+			in: func(apiClient *APIClient) error {
+				_, err := apiClient.AddGroupMembers(context.Background(), 1234567, 4567890, []int{3456789, 5678901})
+				return err
+			},
+			out: "[{\"op\":\"add\",\"path\":\"/users\",\"value\":[3456789,5678901]}]",
+		},
+		{
+			name: "RemoveGroupMembers",
+			in: func(apiClient *APIClient) error {
+				_, err := apiClient.RemoveGroupMembers(context.Background(), 1234567, 4567890, []int{3456789})
+				return err
+			},
+			out: "[{\"op\":\"remove\",\"path\":\"/users\",\"value\":[3456789]}]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_ = tt.in(apiClient)
+		})
+	}
+}
+
+func TestGroupMembersWithoutUserIDs(t *testing.T) {
+	tests := []struct {
+		name string
+		in   func(*APIClient) (*Group, error)
+	}{
+		{
+			name: "AddGroupMembers with nil",
+			in: func(apiClient *APIClient) (*Group, error) {
+				return apiClient.AddGroupMembers(context.Background(), 1234567, 4567890, nil)
+			},
+		},
+		{
+			name: "AddGroupMembers with empty slice",
+			in: func(apiClient *APIClient) (*Group, error) {
+				return apiClient.AddGroupMembers(context.Background(), 1234567, 4567890, []int{})
+			},
+		},
+		{
+			name: "RemoveGroupMembers with nil",
+			in: func(apiClient *APIClient) (*Group, error) {
+				return apiClient.RemoveGroupMembers(context.Background(), 1234567, 4567890, nil)
+			},
+		},
+		{
+			name: "RemoveGroupMembers with empty slice",
+			in: func(apiClient *APIClient) (*Group, error) {
+				return apiClient.RemoveGroupMembers(context.Background(), 1234567, 4567890, []int{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer, counts := internal.NewMockServerToCountRequests(t)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			group, err := tt.in(apiClient)
+			if group != nil || err != nil {
+				internal.Errorf(t, []any{group, err}, []any{nil, nil})
+			}
+			if len(counts()) != 0 {
+				internal.Errorf(t, counts(), "no requests")
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package toggl
 
 import (
 	"context"
+	"iter"
 	"path"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Me represents the properties of an user.
@@ -51,6 +54,15 @@ type UpdateMeRequestBody struct {
 	Timezone           *string `json:"timezone,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateMeRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Check(r.BeginningOfWeek == nil || (0 <= *r.BeginningOfWeek && *r.BeginningOfWeek <= 6), "beginning_of_week", "must be between 0 and 6")
+	v.Check(r.Password == nil || r.CurrentPassword != nil, "current_password", "is required to change password")
+	v.NotEmpty(r.Email, "email")
+	return v.Err()
+}
+
 // UpdateMe updates details for the current user.
 func (c *APIClient) UpdateMe(ctx context.Context, reqBody *UpdateMeRequestBody) (*Me, error) {
 	var me *Me
@@ -75,6 +87,11 @@ type GetMyProjectsQuery struct {
 	IncludeArchived *string `url:"include_archived,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetMyProjectsQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
 // GetMyProjects gets projects.
 func (c *APIClient) GetMyProjects(ctx context.Context, query *GetMyProjectsQuery) ([]*Project, error) {
 	var projects []*Project
@@ -88,6 +105,14 @@ func (c *APIClient) GetMyProjects(ctx context.Context, query *GetMyProjectsQuery
 // GetMyProjectsPaginatedQuery represents the additional parameters of GetMyProjectsPaginated.
 type GetMyProjectsPaginatedQuery struct {
 	StartProjectID *int `url:"start_project_id,omitempty"`
+	PerPage        *int `url:"per_page,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetMyProjectsPaginatedQuery) Validate() error {
+	v := internal.NewValidator(q)
+	v.Positive(q.PerPage, "per_page")
+	return v.Err()
 }
 
 // GetMyProjectsPaginated gets paginated projects.
@@ -100,6 +125,50 @@ func (c *APIClient) GetMyProjectsPaginated(ctx context.Context, query *GetMyProj
 	return projects, nil
 }
 
+// AllMyProjects returns an iterator over projects of the current user across all pages of GetMyProjectsPaginated.
+// The iteration starts from query.StartProjectID if it's specified, and stops at the first error, including the cancellation of ctx.
+func (c *APIClient) AllMyProjects(ctx context.Context, query *GetMyProjectsPaginatedQuery) iter.Seq2[*Project, error] {
+	return func(yield func(*Project, error) bool) {
+		q := GetMyProjectsPaginatedQuery{}
+		if query != nil {
+			q = *query
+		}
+		if q.PerPage == nil {
+			q.PerPage = track.Ptr(iteratorPerPage)
+		}
+		var lastID *int
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, errors.Wrap(err, "failed to iterate my projects"))
+				return
+			}
+			projects, err := c.GetMyProjectsPaginated(ctx, &q)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			yielded := false
+			for _, project := range projects {
+				// The page may start from the last project of the previous page, which has already been yielded.
+				if project.ID != nil && lastID != nil && *project.ID <= *lastID {
+					continue
+				}
+				if !yield(project, nil) {
+					return
+				}
+				if project.ID != nil {
+					lastID = project.ID
+				}
+				yielded = true
+			}
+			if len(projects) < *q.PerPage || !yielded || lastID == nil {
+				return
+			}
+			q.StartProjectID = track.Ptr(*lastID)
+		}
+	}
+}
+
 // GetMyTags returns tags for the current user.
 func (c *APIClient) GetMyTags(ctx context.Context) ([]*Tag, error) {
 	var tags []*Tag
@@ -119,3 +188,25 @@ func (c *APIClient) GetMyClients(ctx context.Context) ([]*Client, error) {
 	}
 	return clients, nil
 }
+
+// GetMyTasksQuery represents the additional parameters of GetMyTasks.
+type GetMyTasksQuery struct {
+	Meta             *bool `url:"meta,omitempty"`
+	Since            *int  `url:"since,omitempty"`
+	IncludeNotActive *bool `url:"include_not_active,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetMyTasksQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
+// GetMyTasks returns tasks from projects in which the user is participating.
+func (c *APIClient) GetMyTasks(ctx context.Context, query *GetMyTasksQuery) ([]*Task, error) {
+	var tasks []*Task
+	apiSpecificPath := path.Join(mePath, "tasks")
+	if err := c.httpGet(ctx, apiSpecificPath, query, &tasks); err != nil {
+		return nil, errors.Wrap(err, "failed to get my tasks")
+	}
+	return tasks, nil
+}
@@ -2,10 +2,13 @@ package toggl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -71,13 +74,10 @@ func TestGetMe(t *testing.T) {
 				err error
 			}{
 				me: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -95,13 +95,10 @@ func TestGetMe(t *testing.T) {
 				err error
 			}{
 				me: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -118,10 +115,10 @@ func TestGetMe(t *testing.T) {
 				internal.Errorf(t, me, tt.out.me)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -188,14 +185,10 @@ func TestUpdateMe(t *testing.T) {
 				err error
 			}{
 				me: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Invalid beginning_of_week\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"28"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Invalid beginning_of_week",
+					Body:       "\"Invalid beginning_of_week\"\n",
 				},
 			},
 		},
@@ -213,13 +206,10 @@ func TestUpdateMe(t *testing.T) {
 				err error
 			}{
 				me: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -237,13 +227,10 @@ func TestUpdateMe(t *testing.T) {
 				err error
 			}{
 				me: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -260,10 +247,10 @@ func TestUpdateMe(t *testing.T) {
 				internal.Errorf(t, me, tt.out.me)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -402,13 +389,10 @@ func TestGetMyOrganizations(t *testing.T) {
 				err           error
 			}{
 				organizations: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -426,13 +410,10 @@ func TestGetMyOrganizations(t *testing.T) {
 				err           error
 			}{
 				organizations: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -450,10 +431,10 @@ func TestGetMyOrganizations(t *testing.T) {
 				internal.Errorf(t, organizations, tt.out.organizations)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -560,13 +541,10 @@ func TestGetMyProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -584,13 +562,10 @@ func TestGetMyProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -608,14 +583,10 @@ func TestGetMyProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Invalid include_archived\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"27"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Invalid include_archived",
+					Body:       "\"Invalid include_archived\"\n",
 				},
 			},
 		},
@@ -633,10 +604,10 @@ func TestGetMyProjects(t *testing.T) {
 				internal.Errorf(t, projects, tt.out.projects)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -781,14 +752,10 @@ func TestGetMyProjectsPaginated(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Invalid start_project_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"27"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Invalid start_project_id",
+					Body:       "\"Invalid start_project_id\"\n",
 				},
 			},
 		},
@@ -806,13 +773,10 @@ func TestGetMyProjectsPaginated(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -830,13 +794,10 @@ func TestGetMyProjectsPaginated(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -854,10 +815,10 @@ func TestGetMyProjectsPaginated(t *testing.T) {
 				internal.Errorf(t, projects, tt.out.projects)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -968,13 +929,10 @@ func TestGetMyTags(t *testing.T) {
 				err  error
 			}{
 				tags: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -992,13 +950,10 @@ func TestGetMyTags(t *testing.T) {
 				err  error
 			}{
 				tags: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -1016,10 +971,10 @@ func TestGetMyTags(t *testing.T) {
 				internal.Errorf(t, tags, tt.out.tags)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -1086,13 +1041,10 @@ func TestGetMyClients(t *testing.T) {
 				err     error
 			}{
 				clients: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -1110,13 +1062,10 @@ func TestGetMyClients(t *testing.T) {
 				err     error
 			}{
 				clients: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -1134,10 +1083,10 @@ func TestGetMyClients(t *testing.T) {
 				internal.Errorf(t, clients, tt.out.clients)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -1147,3 +1096,259 @@ func TestGetMyClients(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMyTasks(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			tasks []*Task
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/me/get_my_tasks_200_ok.json",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: []*Task{
+					{
+						ID:               track.Ptr(12345678),
+						Name:             track.Ptr("MyTask"),
+						WorkspaceID:      track.Ptr(1234567),
+						ProjectID:        track.Ptr(123456789),
+						Recurring:        track.Ptr(false),
+						Active:           track.Ptr(true),
+						At:               track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+						EstimatedSeconds: track.Ptr(3600),
+						TrackedSeconds:   track.Ptr(1800),
+					},
+					{
+						ID:               track.Ptr(23456789),
+						Name:             track.Ptr("AnotherTask"),
+						WorkspaceID:      track.Ptr(1234567),
+						ProjectID:        track.Ptr(123456789),
+						UserID:           track.Ptr(2345678),
+						Recurring:        track.Ptr(false),
+						Active:           track.Ptr(false),
+						At:               track.Ptr(time.Date(2022, time.February, 3, 4, 5, 6, 0, time.Local)),
+						EstimatedSeconds: track.Ptr(0),
+						TrackedSeconds:   track.Ptr(0),
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/me/get_my_tasks_400_bad_request.json",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid since",
+					Body:       "\"Invalid since\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/me/get_my_tasks_401_unauthorized",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/me/get_my_tasks_403_forbidden",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSpecificPath := path.Join(mePath, "tasks")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			tasks, err := apiClient.GetMyTasks(context.Background(), &GetMyTasksQuery{})
+
+			if !reflect.DeepEqual(tasks, tt.out.tasks) {
+				internal.Errorf(t, tasks, tt.out.tasks)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMyTasksQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetMyTasksQuery
+		out  string
+	}{
+		{
+			name: "GetMyTasksQuery is nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "meta=true",
+			in:   &GetMyTasksQuery{Meta: track.Ptr(true)},
+			out:  "meta=true",
+		},
+		{
+			name: "include_not_active=true&since=1640995200",
+			in:   &GetMyTasksQuery{Since: track.Ptr(1640995200), IncludeNotActive: track.Ptr(true)},
+			out:  "include_not_active=true&since=1640995200",
+		},
+		{
+			name: "GetMyTasksQuery is empty",
+			in:   &GetMyTasksQuery{},
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertQuery(t, tt.out)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.GetMyTasks(context.Background(), tt.in)
+		})
+	}
+}
+
+func TestAllMyProjects(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			query     *GetMyProjectsPaginatedQuery
+			inclusive bool
+		}
+		out []string
+	}{
+		{
+			name: "default page size",
+			in: struct {
+				query     *GetMyProjectsPaginatedQuery
+				inclusive bool
+			}{query: nil},
+			out: []string{""},
+		},
+		{
+			name: "exclusive cursor",
+			in: struct {
+				query     *GetMyProjectsPaginatedQuery
+				inclusive bool
+			}{query: &GetMyProjectsPaginatedQuery{PerPage: track.Ptr(2)}},
+			out: []string{"", "20", "40"},
+		},
+		{
+			name: "inclusive cursor",
+			in: struct {
+				query     *GetMyProjectsPaginatedQuery
+				inclusive bool
+			}{query: &GetMyProjectsPaginatedQuery{PerPage: track.Ptr(2)}, inclusive: true},
+			out: []string{"", "20", "30", "40", "50"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Projects whose IDs are 10, 20, ..., 50 are served per_page per page from start_project_id.
+			var startProjectIDs []string
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				startProjectIDs = append(startProjectIDs, r.URL.Query().Get("start_project_id"))
+				start, _ := strconv.Atoi(r.URL.Query().Get("start_project_id"))
+				perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+				projects := []*Project{}
+				for id := 10; id <= 50 && len(projects) < perPage; id += 10 {
+					if id > start || (tt.in.inclusive && id == start) {
+						projects = append(projects, &Project{ID: track.Ptr(id)})
+					}
+				}
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				json.NewEncoder(w).Encode(projects)
+			}))
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+			var projectIDs []int
+			for project, err := range apiClient.AllMyProjects(context.Background(), tt.in.query) {
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				projectIDs = append(projectIDs, *project.ID)
+			}
+
+			wantProjectIDs := []int{10, 20, 30, 40, 50}
+			if !reflect.DeepEqual(projectIDs, wantProjectIDs) {
+				internal.Errorf(t, projectIDs, wantProjectIDs)
+			}
+			if !reflect.DeepEqual(startProjectIDs, tt.out) {
+				internal.Errorf(t, startProjectIDs, tt.out)
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package toggl
 
 import (
 	"context"
+	"iter"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Organization represents the properties of an organization.
@@ -46,45 +49,109 @@ func (c *APIClient) GetOrganization(ctx context.Context, organizationID int) (*O
 	return organization, nil
 }
 
+// CreateOrganizationRequestBody represents a request body of CreateOrganization.
+type CreateOrganizationRequestBody struct {
+	Name          *string `json:"name,omitempty"`
+	WorkspaceName *string `json:"workspace_name,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateOrganizationRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
+// CreatedOrganization represents the properties of an organization created by CreateOrganization,
+// which includes its first workspace.
+type CreatedOrganization struct {
+	ID            *int      `json:"id,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	PricingPlanID *int      `json:"pricing_plan_id,omitempty"`
+	WorkspaceID   *int      `json:"workspace_id,omitempty"`
+	WorkspaceName *string   `json:"workspace_name,omitempty"`
+	Permissions   []*string `json:"permissions,omitempty"`
+}
+
+// CreateOrganization creates an organization with a workspace, and makes the current user its owner.
+func (c *APIClient) CreateOrganization(ctx context.Context, reqBody *CreateOrganizationRequestBody) (*CreatedOrganization, error) {
+	var createdOrganization *CreatedOrganization
+	if err := c.httpPost(ctx, organizationsPath, reqBody, &createdOrganization); err != nil {
+		return nil, errors.Wrap(err, "failed to create organization")
+	}
+	return createdOrganization, nil
+}
+
+// UpdateOrganizationRequestBody represents a request body of UpdateOrganization.
+type UpdateOrganizationRequestBody struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateOrganizationRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
+}
+
+// UpdateOrganization updates an organization.
+func (c *APIClient) UpdateOrganization(ctx context.Context, organizationID int, reqBody *UpdateOrganizationRequestBody) error {
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, nil); err != nil {
+		return errors.Wrap(err, "failed to update organization")
+	}
+	return nil
+}
+
 // OrganizationUser represents the properties of a user in an organization.
 // Some properties differ from those described in the documentation.
 type OrganizationUser struct {
-	ID             *int         `json:"id,omitempty"`
-	Name           *string      `json:"name,omitempty"`
-	Email          *string      `json:"email,omitempty"`
-	UserID         *int         `json:"user_id,omitempty"`
-	AvatarURL      *string      `json:"avatar_url,omitempty"`
-	Admin          *bool        `json:"admin,omitempty"`
-	Owner          *bool        `json:"owner,omitempty"`
-	Joined         *bool        `json:"joined,omitempty"`
-	InvitationCode *string      `json:"invitation_code,omitempty"`
-	Inactive       *bool        `json:"inactive,omitempty"`
-	CanEditEmail   *bool        `json:"can_edit_email,omitempty"`
-	Workspaces     []*workspace `json:"workspaces,omitempty"`
-	Groups         []*group     `json:"groups,omitempty"`
-}
-
-type workspace struct {
+	ID             *int                         `json:"id,omitempty"`
+	Name           *string                      `json:"name,omitempty"`
+	Email          *string                      `json:"email,omitempty"`
+	UserID         *int                         `json:"user_id,omitempty"`
+	AvatarURL      *string                      `json:"avatar_url,omitempty"`
+	Admin          *bool                        `json:"admin,omitempty"`
+	Owner          *bool                        `json:"owner,omitempty"`
+	Joined         *bool                        `json:"joined,omitempty"`
+	InvitationCode *string                      `json:"invitation_code,omitempty"`
+	Inactive       *bool                        `json:"inactive,omitempty"`
+	CanEditEmail   *bool                        `json:"can_edit_email,omitempty"`
+	Workspaces     []*OrganizationUserWorkspace `json:"workspaces,omitempty"`
+	Groups         []*OrganizationUserGroup     `json:"groups,omitempty"`
+}
+
+// OrganizationUserWorkspace represents a workspace which an organization user belongs to.
+type OrganizationUserWorkspace struct {
 	Admin       *bool   `json:"admin,omitempty"`
 	Name        *string `json:"name,omitempty"`
 	WorkspaceID *int    `json:"workspace_id,omitempty"`
 }
 
-type group struct {
+// OrganizationUserGroup represents a group which an organization user belongs to.
+type OrganizationUserGroup struct {
 	GroupID *int    `json:"group_id,omitempty"`
 	Name    *string `json:"name,omitempty"`
 }
 
 // GetOrganizationUsersQuery represents the additional parameters of GetOrganizationUsers.
 type GetOrganizationUsersQuery struct {
-	Filter       *string `url:"filter,omitempty"`
-	ActiveStatus *string `url:"active_status,omitempty"`
-	OnlyAdmins   *string `url:"only_admins,omitempty"`
-	Groups       *string `url:"groups,omitempty"`
-	Workspaces   *string `url:"workspaces,omitempty"`
-	Page         *int    `url:"page,omitempty"`
-	PerPage      *int    `url:"per_page,omitempty"`
-	SortDir      *string `url:"sort_dir,omitempty"`
+	Filter       *string       `url:"filter,omitempty"`
+	ActiveStatus *ActiveStatus `url:"active_status,omitempty"`
+	OnlyAdmins   *string       `url:"only_admins,omitempty"`
+	Groups       *string       `url:"groups,omitempty"`
+	Workspaces   *string       `url:"workspaces,omitempty"`
+	Page         *int          `url:"page,omitempty"`
+	PerPage      *int          `url:"per_page,omitempty"`
+	SortDir      *SortDir      `url:"sort_dir,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetOrganizationUsersQuery) Validate() error {
+	v := internal.NewValidator(q)
+	v.Positive(q.Page, "page")
+	v.Positive(q.PerPage, "per_page")
+	return v.Err()
 }
 
 // GetOrganizationUsers returns list of users in an organization.
@@ -96,3 +163,120 @@ func (c *APIClient) GetOrganizationUsers(ctx context.Context, organizationID int
 	}
 	return organizationUsers, nil
 }
+
+// AllOrganizationUsers returns an iterator over users in an organization across all pages.
+// The iteration starts from query.Page if it's specified, and stops at the first error, including the cancellation of ctx.
+func (c *APIClient) AllOrganizationUsers(ctx context.Context, organizationID int, query *GetOrganizationUsersQuery) iter.Seq2[*OrganizationUser, error] {
+	return func(yield func(*OrganizationUser, error) bool) {
+		q := GetOrganizationUsersQuery{}
+		if query != nil {
+			q = *query
+		}
+		if q.PerPage == nil {
+			q.PerPage = track.Ptr(iteratorPerPage)
+		}
+		page := 1
+		if q.Page != nil {
+			page = *q.Page
+		}
+		for ; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, errors.Wrap(err, "failed to iterate organization users"))
+				return
+			}
+			q.Page = track.Ptr(page)
+			organizationUsers, err := c.GetOrganizationUsers(ctx, organizationID, &q)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, organizationUser := range organizationUsers {
+				if !yield(organizationUser, nil) {
+					return
+				}
+			}
+			if len(organizationUsers) < *q.PerPage {
+				return
+			}
+		}
+	}
+}
+
+// InviteOrganizationUsersRequestBody represents a request body of InviteOrganizationUsers.
+type InviteOrganizationUsersRequestBody struct {
+	Emails     []*string                    `json:"emails,omitempty"`
+	Workspaces []*OrganizationUserWorkspace `json:"workspaces,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *InviteOrganizationUsersRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Emails, "emails")
+	return v.Err()
+}
+
+// InvitationResult represents the result of InviteOrganizationUsers.
+type InvitationResult struct {
+	Data     []*Invitation `json:"data,omitempty"`
+	Messages []*string     `json:"messages,omitempty"`
+}
+
+// Invitation represents the properties of an invitation to an organization.
+type Invitation struct {
+	InvitationID   *int    `json:"invitation_id,omitempty"`
+	Email          *string `json:"email,omitempty"`
+	InviteURL      *string `json:"invite_url,omitempty"`
+	OrganizationID *int    `json:"organization_id,omitempty"`
+	RecipientID    *int    `json:"recipient_id,omitempty"`
+	SenderID       *int    `json:"sender_id,omitempty"`
+}
+
+// InviteOrganizationUsers invites users to an organization and its workspaces by email.
+func (c *APIClient) InviteOrganizationUsers(ctx context.Context, organizationID int, reqBody *InviteOrganizationUsersRequestBody) (*InvitationResult, error) {
+	var invitationResult *InvitationResult
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "invitation")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &invitationResult); err != nil {
+		return nil, errors.Wrap(err, "failed to invite organization users")
+	}
+	return invitationResult, nil
+}
+
+// UpdateOrganizationUserRequestBody represents a request body of UpdateOrganizationUser.
+// Set Inactive to true to deactivate the user, or false to reactivate.
+type UpdateOrganizationUserRequestBody struct {
+	Email             *string                      `json:"email,omitempty"`
+	Groups            []*int                       `json:"groups,omitempty"`
+	Inactive          *bool                        `json:"inactive,omitempty"`
+	OrganizationAdmin *bool                        `json:"organization_admin,omitempty"`
+	Workspaces        []*OrganizationUserWorkspace `json:"workspaces,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateOrganizationUserRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Email, "email")
+	return v.Err()
+}
+
+// UpdateOrganizationUser updates a user in an organization.
+func (c *APIClient) UpdateOrganizationUser(ctx context.Context, organizationID, organizationUserID int, reqBody *UpdateOrganizationUserRequestBody) error {
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "users", strconv.Itoa(organizationUserID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, nil); err != nil {
+		return errors.Wrap(err, "failed to update organization user")
+	}
+	return nil
+}
+
+type removeOrganizationUsersRequestBody struct {
+	Delete []int `json:"delete"`
+}
+
+// RemoveOrganizationUsers removes users from an organization and all of its workspaces.
+func (c *APIClient) RemoveOrganizationUsers(ctx context.Context, organizationID int, organizationUserIDs []int) error {
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "users")
+	reqBody := &removeOrganizationUsersRequestBody{Delete: organizationUserIDs}
+	if err := c.httpPatch(ctx, apiSpecificPath, reqBody, nil); err != nil {
+		return errors.Wrap(err, "failed to remove organization users")
+	}
+	return nil
+}
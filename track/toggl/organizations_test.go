@@ -2,8 +2,10 @@ package toggl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
 	"strconv"
@@ -79,14 +81,10 @@ func TestGetOrganization(t *testing.T) {
 				err          error
 			}{
 				organization: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid organization_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"37"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid organization_id",
+					Body:       "\"Missing or invalid organization_id\"\n",
 				},
 			},
 		},
@@ -104,13 +102,10 @@ func TestGetOrganization(t *testing.T) {
 				err          error
 			}{
 				organization: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -128,13 +123,10 @@ func TestGetOrganization(t *testing.T) {
 				err          error
 			}{
 				organization: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -153,10 +145,10 @@ func TestGetOrganization(t *testing.T) {
 				internal.Errorf(t, organization, tt.out.organization)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -205,13 +197,19 @@ func TestGetOrganizationUsers(t *testing.T) {
 						InvitationCode: nil,
 						Inactive:       track.Ptr(false),
 						CanEditEmail:   track.Ptr(false),
-						Workspaces: []*workspace{
+						Workspaces: []*OrganizationUserWorkspace{
 							{
 								WorkspaceID: track.Ptr(3456789),
 								Admin:       track.Ptr(true),
 								Name:        track.Ptr("Workspace1"),
 							},
 						},
+						Groups: []*OrganizationUserGroup{
+							{
+								GroupID: track.Ptr(4567890),
+								Name:    track.Ptr("Group1"),
+							},
+						},
 					},
 				},
 				err: nil,
@@ -231,14 +229,10 @@ func TestGetOrganizationUsers(t *testing.T) {
 				err               error
 			}{
 				organizationUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid organization_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"37"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid organization_id",
+					Body:       "\"Missing or invalid organization_id\"\n",
 				},
 			},
 		},
@@ -256,13 +250,10 @@ func TestGetOrganizationUsers(t *testing.T) {
 				err               error
 			}{
 				organizationUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -280,13 +271,10 @@ func TestGetOrganizationUsers(t *testing.T) {
 				err               error
 			}{
 				organizationUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -305,10 +293,10 @@ func TestGetOrganizationUsers(t *testing.T) {
 				internal.Errorf(t, organizationUsers, tt.out.organizationUsers)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -369,3 +357,858 @@ func TestGetOrganizationUsersQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestAllOrganizationUsers(t *testing.T) {
+	// Users 1 to 3 are served 2 per page.
+	var requestedPages []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+		organizationUsers := []*OrganizationUser{}
+		for id := 2*page - 1; id <= 2*page && id <= 3; id++ {
+			organizationUsers = append(organizationUsers, &OrganizationUser{ID: track.Ptr(id)})
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(organizationUsers)
+	}))
+	defer mockServer.Close()
+
+	organizationID := 1234567
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+	var organizationUserIDs []int
+	query := &GetOrganizationUsersQuery{PerPage: track.Ptr(2)}
+	for organizationUser, err := range apiClient.AllOrganizationUsers(context.Background(), organizationID, query) {
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		organizationUserIDs = append(organizationUserIDs, *organizationUser.ID)
+	}
+
+	wantOrganizationUserIDs := []int{1, 2, 3}
+	if !reflect.DeepEqual(organizationUserIDs, wantOrganizationUserIDs) {
+		internal.Errorf(t, organizationUserIDs, wantOrganizationUserIDs)
+	}
+	wantRequestedPages := []string{"1", "2"}
+	if !reflect.DeepEqual(requestedPages, wantRequestedPages) {
+		internal.Errorf(t, requestedPages, wantRequestedPages)
+	}
+	if query.Page != nil {
+		internal.Errorf(t, query.Page, nil)
+	}
+
+	// Without per_page, the default page size is requested, and the short first page is the last one.
+	requestedPages = nil
+	for _, err := range apiClient.AllOrganizationUsers(context.Background(), organizationID, nil) {
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if want := []string{"1"}; !reflect.DeepEqual(requestedPages, want) {
+		internal.Errorf(t, requestedPages, want)
+	}
+}
+
+func TestAllOrganizationUsersError(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer mockServer.Close()
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+	count := 0
+	for organizationUser, err := range apiClient.AllOrganizationUsers(context.Background(), 1234567, nil) {
+		count++
+		if organizationUser != nil {
+			internal.Errorf(t, organizationUser, nil)
+		}
+		if !track.IsForbidden(err) {
+			internal.Errorf(t, err, http.StatusForbidden)
+		}
+	}
+	if count != 1 {
+		internal.Errorf(t, count, 1)
+	}
+}
+
+func TestInviteOrganizationUsers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			invitationResult *InvitationResult
+			err              error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/organizations/invite_organization_users_200_ok.json",
+			},
+			out: struct {
+				invitationResult *InvitationResult
+				err              error
+			}{
+				invitationResult: &InvitationResult{
+					Data: []*Invitation{
+						{
+							InvitationID:   track.Ptr(5678901),
+							Email:          track.Ptr("invitee@example.com"),
+							InviteURL:      track.Ptr("https://track.toggl.com/invite/abcdef"),
+							OrganizationID: track.Ptr(1234567),
+							RecipientID:    track.Ptr(6789012),
+							SenderID:       track.Ptr(2345678),
+						},
+					},
+					Messages: []*string{track.Ptr("Invitation sent to invitee@example.com")},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/organizations/invite_organization_users_400_bad_request.json",
+			},
+			out: struct {
+				invitationResult *InvitationResult
+				err              error
+			}{
+				invitationResult: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Emails are not valid",
+					Body:       "\"Emails are not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/organizations/invite_organization_users_401_unauthorized",
+			},
+			out: struct {
+				invitationResult *InvitationResult
+				err              error
+			}{
+				invitationResult: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "402 Payment Required",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusPaymentRequired,
+				testdataFile: "testdata/organizations/invite_organization_users_402_payment_required.json",
+			},
+			out: struct {
+				invitationResult *InvitationResult
+				err              error
+			}{
+				invitationResult: nil,
+				err: &track.APIError{
+					StatusCode: 402,
+					Message:    "Maximum number of users has been reached",
+					Body:       "\"Maximum number of users has been reached\"\n",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/organizations/invite_organization_users_403_forbidden",
+			},
+			out: struct {
+				invitationResult *InvitationResult
+				err              error
+			}{
+				invitationResult: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "invitation")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			invitationResult, err := apiClient.InviteOrganizationUsers(context.Background(), organizationID, &InviteOrganizationUsersRequestBody{Emails: []*string{track.Ptr("user@example.com")}})
+
+			if !reflect.DeepEqual(invitationResult, tt.out.invitationResult) {
+				internal.Errorf(t, invitationResult, tt.out.invitationResult)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestInviteOrganizationUsersRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *InviteOrganizationUsersRequestBody
+		out  string
+	}{
+		{
+			name: "slice of string",
+			in: &InviteOrganizationUsersRequestBody{
+				Emails: []*string{track.Ptr("invitee@example.com")},
+			},
+			out: "{\"emails\":[\"invitee@example.com\"]}",
+		},
+		{
+			name: "slice of struct",
+			in: &InviteOrganizationUsersRequestBody{
+				Emails: []*string{track.Ptr("invitee@example.com")},
+				Workspaces: []*OrganizationUserWorkspace{
+					{WorkspaceID: track.Ptr(3456789), Admin: track.Ptr(true)},
+				},
+			},
+			out: "{\"emails\":[\"invitee@example.com\"],\"workspaces\":[{\"admin\":true,\"workspace_id\":3456789}]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			_, _ = apiClient.InviteOrganizationUsers(context.Background(), organizationID, tt.in)
+		})
+	}
+}
+
+func TestUpdateOrganizationUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/organizations/update_organization_user_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/organizations/update_organization_user_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/organizations/update_organization_user_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/organizations/update_organization_user_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/organizations/update_organization_user_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			organizationUserID := 2345678
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "users", strconv.Itoa(organizationUserID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.UpdateOrganizationUser(context.Background(), organizationID, organizationUserID, &UpdateOrganizationUserRequestBody{})
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateOrganizationUserRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateOrganizationUserRequestBody
+		out  string
+	}{
+		{
+			name: "deactivate",
+			in: &UpdateOrganizationUserRequestBody{
+				Inactive: track.Ptr(true),
+			},
+			out: "{\"inactive\":true}",
+		},
+		{
+			name: "string, bool, and slices",
+			in: &UpdateOrganizationUserRequestBody{
+				Email:             track.Ptr("user@example.com"),
+				Groups:            []*int{track.Ptr(4567890)},
+				OrganizationAdmin: track.Ptr(false),
+				Workspaces: []*OrganizationUserWorkspace{
+					{WorkspaceID: track.Ptr(3456789), Admin: track.Ptr(false)},
+				},
+			},
+			out: "{\"email\":\"user@example.com\",\"groups\":[4567890],\"organization_admin\":false,\"workspaces\":[{\"admin\":false,\"workspace_id\":3456789}]}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			organizationUserID := 2345678
+			_ = apiClient.UpdateOrganizationUser(context.Background(), organizationID, organizationUserID, tt.in)
+		})
+	}
+}
+
+func TestRemoveOrganizationUsers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/organizations/remove_organization_users_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/organizations/remove_organization_users_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/organizations/remove_organization_users_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/organizations/remove_organization_users_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "users")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.RemoveOrganizationUsers(context.Background(), organizationID, []int{2345678})
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoveOrganizationUsersRequestBody(t *testing.T) {
+	mockServer := internal.NewMockServerToAssertRequestBody(t, "{\"delete\":[2345678,3456789]}")
+	defer mockServer.Close()
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	organizationID := 1234567
+	_ = apiClient.RemoveOrganizationUsers(context.Background(), organizationID, []int{2345678, 3456789})
+}
+
+func TestCreateOrganization(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			createdOrganization *CreatedOrganization
+			err                 error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/organizations/create_organization_200_ok.json",
+			},
+			out: struct {
+				createdOrganization *CreatedOrganization
+				err                 error
+			}{
+				createdOrganization: &CreatedOrganization{
+					ID:            track.Ptr(1234567),
+					Name:          track.Ptr("Sandbox"),
+					PricingPlanID: track.Ptr(0),
+					WorkspaceID:   track.Ptr(2345678),
+					WorkspaceName: track.Ptr("Sandbox Workspace"),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/organizations/create_organization_400_bad_request.json",
+			},
+			out: struct {
+				createdOrganization *CreatedOrganization
+				err                 error
+			}{
+				createdOrganization: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Organization name must be present",
+					Body:       "\"Organization name must be present\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/organizations/create_organization_401_unauthorized",
+			},
+			out: struct {
+				createdOrganization *CreatedOrganization
+				err                 error
+			}{
+				createdOrganization: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/organizations/create_organization_403_forbidden",
+			},
+			out: struct {
+				createdOrganization *CreatedOrganization
+				err                 error
+			}{
+				createdOrganization: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiSpecificPath := organizationsPath
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			createdOrganization, err := apiClient.CreateOrganization(context.Background(), &CreateOrganizationRequestBody{Name: track.Ptr("organization")})
+
+			if !reflect.DeepEqual(createdOrganization, tt.out.createdOrganization) {
+				internal.Errorf(t, createdOrganization, tt.out.createdOrganization)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateOrganizationRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateOrganizationRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &CreateOrganizationRequestBody{
+				Name: track.Ptr("Sandbox"),
+			},
+			out: "{\"name\":\"Sandbox\"}",
+		},
+		{
+			name: "strings",
+			in: &CreateOrganizationRequestBody{
+				Name:          track.Ptr("Sandbox"),
+				WorkspaceName: track.Ptr("Sandbox Workspace"),
+			},
+			out: "{\"name\":\"Sandbox\",\"workspace_name\":\"Sandbox Workspace\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.CreateOrganization(context.Background(), tt.in)
+		})
+	}
+}
+
+func TestUpdateOrganization(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/organizations/update_organization_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/organizations/update_organization_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Organization name must be present",
+					Body:       "\"Organization name must be present\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/organizations/update_organization_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/organizations/update_organization_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/organizations/update_organization_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.UpdateOrganization(context.Background(), organizationID, &UpdateOrganizationRequestBody{})
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateOrganizationRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateOrganizationRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &UpdateOrganizationRequestBody{
+				Name: track.Ptr("Renamed"),
+			},
+			out: "{\"name\":\"Renamed\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			_ = apiClient.UpdateOrganization(context.Background(), organizationID, tt.in)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package toggl
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Operations of JSON Patch supported by Toggl API v9.
+const (
+	PatchOpAdd     string = "add"
+	PatchOpRemove  string = "remove"
+	PatchOpReplace string = "replace"
+)
+
+// PatchOperation represents an operation of JSON Patch (RFC 6902) used by bulk editing endpoints.
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// PatchResult represents the result of a bulk editing endpoint.
+type PatchResult struct {
+	Success []*int          `json:"success,omitempty"`
+	Failure []*PatchFailure `json:"failure,omitempty"`
+}
+
+// PatchFailure represents the reason why a resource failed to be edited.
+type PatchFailure struct {
+	ID      *int    `json:"id,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func joinIDs(ids []int) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, strconv.Itoa(id))
+	}
+	return strings.Join(s, ",")
+}
+
+// chunkIDs splits ids into chunks whose length is at most size.
+func chunkIDs(ids []int, size int) [][]int {
+	var chunks [][]int
+	for size < len(ids) {
+		ids, chunks = ids[size:], append(chunks, ids[:size])
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// patchInChunks applies the operations to the resources under basePath by their IDs.
+// The IDs are split into requests of at most size IDs, and their results are merged.
+// If a request fails, the results of the preceding requests are returned with the error.
+// No request is sent if ids is empty.
+func (c *APIClient) patchInChunks(ctx context.Context, basePath string, ids []int, size int, operations []*PatchOperation) (*PatchResult, error) {
+	patchResult := &PatchResult{}
+	for _, chunk := range chunkIDs(ids, size) {
+		var chunkResult *PatchResult
+		if err := c.httpPatch(ctx, path.Join(basePath, joinIDs(chunk)), operations, &chunkResult); err != nil {
+			return patchResult, err
+		}
+		if chunkResult != nil {
+			patchResult.Success = append(patchResult.Success, chunkResult.Success...)
+			patchResult.Failure = append(patchResult.Failure, chunkResult.Failure...)
+		}
+	}
+	return patchResult, nil
+}
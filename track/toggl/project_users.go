@@ -0,0 +1,160 @@
+package toggl
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+// ProjectUser represents the properties of a user who is a member of a project.
+type ProjectUser struct {
+	ID              *int       `json:"id,omitempty"`
+	ProjectID       *int       `json:"project_id,omitempty"`
+	UserID          *int       `json:"user_id,omitempty"`
+	WorkspaceID     *int       `json:"workspace_id,omitempty"`
+	GroupID         *int       `json:"group_id,omitempty"`
+	Manager         *bool      `json:"manager,omitempty"`
+	Rate            *int       `json:"rate,omitempty"`
+	RateLastUpdated *string    `json:"rate_last_updated,omitempty"`
+	LaborCost       *int       `json:"labor_cost,omitempty"`
+	At              *time.Time `json:"at,omitempty"`
+}
+
+// GetProjectUsersQuery represents the additional parameters of GetProjectUsers.
+type GetProjectUsersQuery struct {
+	ProjectIDs       []int `url:"project_ids,comma,omitempty"`
+	UserID           *int  `url:"user_id,omitempty"`
+	WithGroupMembers *bool `url:"with_group_members,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetProjectUsersQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
+// GetProjectUsers returns project users for given workspace.
+func (c *APIClient) GetProjectUsers(ctx context.Context, workspaceID int, query *GetProjectUsersQuery) ([]*ProjectUser, error) {
+	var projectUsers []*ProjectUser
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users")
+	if err := c.httpGet(ctx, apiSpecificPath, query, &projectUsers); err != nil {
+		return nil, errors.Wrap(err, "failed to get project users")
+	}
+	return projectUsers, nil
+}
+
+// AddProjectUserRequestBody represents a request body of AddProjectUser.
+type AddProjectUserRequestBody struct {
+	ProjectID      *int            `json:"project_id,omitempty"`
+	UserID         *int            `json:"user_id,omitempty"`
+	GroupID        *int            `json:"group_id,omitempty"`
+	Manager        *bool           `json:"manager,omitempty"`
+	Rate           *int            `json:"rate,omitempty"`
+	RateChangeMode *RateChangeMode `json:"rate_change_mode,omitempty"`
+	LaborCost      *int            `json:"labor_cost,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *AddProjectUserRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.ProjectID, "project_id")
+	v.Required(r.UserID, "user_id")
+	return v.Err()
+}
+
+// AddProjectUser adds a user to a project for given workspace.
+func (c *APIClient) AddProjectUser(ctx context.Context, workspaceID int, reqBody *AddProjectUserRequestBody) (*ProjectUser, error) {
+	var projectUser *ProjectUser
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &projectUser); err != nil {
+		return nil, errors.Wrap(err, "failed to add project user")
+	}
+	return projectUser, nil
+}
+
+// UpdateProjectUserRequestBody represents a request body of UpdateProjectUser.
+type UpdateProjectUserRequestBody struct {
+	Manager             *bool               `json:"manager,omitempty"`
+	Rate                track.Nullable[int] `json:"rate,omitempty"`
+	RateChangeMode      *RateChangeMode     `json:"rate_change_mode,omitempty"`
+	LaborCost           track.Nullable[int] `json:"labor_cost,omitempty"`
+	LaborCostChangeMode *RateChangeMode     `json:"labor_cost_change_mode,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateProjectUserRequestBody) Validate() error {
+	return internal.NewValidator(r).Err()
+}
+
+// UpdateProjectUser updates a project user for given workspace.
+func (c *APIClient) UpdateProjectUser(ctx context.Context, workspaceID, projectUserID int, reqBody *UpdateProjectUserRequestBody) (*ProjectUser, error) {
+	var projectUser *ProjectUser
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users", strconv.Itoa(projectUserID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &projectUser); err != nil {
+		return nil, errors.Wrap(err, "failed to update project user")
+	}
+	return projectUser, nil
+}
+
+// maxPatchProjectUserIDs is the maximum number of project users which can be patched in a request.
+const maxPatchProjectUserIDs = 100
+
+// ProjectUserPatch builds operations of PatchProjectUsers.
+type ProjectUserPatch struct {
+	operations []*PatchOperation
+}
+
+// NewProjectUserPatch creates an empty ProjectUserPatch.
+func NewProjectUserPatch() *ProjectUserPatch {
+	return &ProjectUserPatch{}
+}
+
+func (p *ProjectUserPatch) replace(field string, value any) *ProjectUserPatch {
+	p.operations = append(p.operations, &PatchOperation{Op: PatchOpReplace, Path: "/" + field, Value: value})
+	return p
+}
+
+// Manager sets whether project users are managers of their projects.
+func (p *ProjectUserPatch) Manager(manager bool) *ProjectUserPatch {
+	return p.replace("manager", manager)
+}
+
+// Rate sets the hourly rate of project users.
+func (p *ProjectUserPatch) Rate(rate int) *ProjectUserPatch {
+	return p.replace("rate", rate)
+}
+
+// LaborCost sets the hourly labor cost of project users.
+func (p *ProjectUserPatch) LaborCost(laborCost int) *ProjectUserPatch {
+	return p.replace("labor_cost", laborCost)
+}
+
+// Operations returns the operations built so far.
+func (p *ProjectUserPatch) Operations() []*PatchOperation {
+	return p.operations
+}
+
+// PatchProjectUsers applies the operations to project users for given workspace.
+// Since the number of project users in a request is limited, projectUserIDs are split into several requests if necessary,
+// and their results are merged. If a request fails, the results of the preceding requests are returned with the error.
+func (c *APIClient) PatchProjectUsers(ctx context.Context, workspaceID int, projectUserIDs []int, operations []*PatchOperation) (*PatchResult, error) {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users")
+	patchResult, err := c.patchInChunks(ctx, apiSpecificPath, projectUserIDs, maxPatchProjectUserIDs, operations)
+	if err != nil {
+		return patchResult, errors.Wrap(err, "failed to patch project users")
+	}
+	return patchResult, nil
+}
+
+// DeleteProjectUser removes a user from a project for given workspace.
+func (c *APIClient) DeleteProjectUser(ctx context.Context, workspaceID, projectUserID int) error {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users", strconv.Itoa(projectUserID))
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete project user")
+	}
+	return nil
+}
@@ -0,0 +1,892 @@
+package toggl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestGetProjectUsers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			projectUsers []*ProjectUser
+			err          error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/project_users/get_project_users_200_ok.json",
+			},
+			out: struct {
+				projectUsers []*ProjectUser
+				err          error
+			}{
+				projectUsers: []*ProjectUser{
+					{
+						ID:              track.Ptr(4567890),
+						ProjectID:       track.Ptr(3456789),
+						UserID:          track.Ptr(5678901),
+						WorkspaceID:     track.Ptr(2345678),
+						Manager:         track.Ptr(true),
+						Rate:            track.Ptr(100),
+						RateLastUpdated: track.Ptr("2022-01-02T03:04:05.000Z"),
+						LaborCost:       track.Ptr(50),
+						At:              track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					},
+					{
+						ID:          track.Ptr(6789012),
+						ProjectID:   track.Ptr(3456789),
+						UserID:      track.Ptr(7890123),
+						WorkspaceID: track.Ptr(2345678),
+						Manager:     track.Ptr(false),
+						At:          track.Ptr(time.Date(2022, time.February, 3, 4, 5, 6, 0, time.Local)),
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/project_users/get_project_users_400_bad_request.json",
+			},
+			out: struct {
+				projectUsers []*ProjectUser
+				err          error
+			}{
+				projectUsers: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/project_users/get_project_users_401_unauthorized",
+			},
+			out: struct {
+				projectUsers []*ProjectUser
+				err          error
+			}{
+				projectUsers: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/project_users/get_project_users_403_forbidden",
+			},
+			out: struct {
+				projectUsers []*ProjectUser
+				err          error
+			}{
+				projectUsers: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			projectUsers, err := apiClient.GetProjectUsers(context.Background(), workspaceID, &GetProjectUsersQuery{})
+
+			if !reflect.DeepEqual(projectUsers, tt.out.projectUsers) {
+				internal.Errorf(t, projectUsers, tt.out.projectUsers)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetProjectUsersQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetProjectUsersQuery
+		out  string
+	}{
+		{
+			name: "GetProjectUsersQuery is nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "project_ids=3456789%2C4567890",
+			in:   &GetProjectUsersQuery{ProjectIDs: []int{3456789, 4567890}},
+			out:  "project_ids=3456789%2C4567890",
+		},
+		{
+			name: "user_id=5678901&with_group_members=true",
+			in:   &GetProjectUsersQuery{UserID: track.Ptr(5678901), WithGroupMembers: track.Ptr(true)},
+			out:  "user_id=5678901&with_group_members=true",
+		},
+		{
+			name: "GetProjectUsersQuery is empty",
+			in:   &GetProjectUsersQuery{},
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertQuery(t, tt.out)
+			defer mockServer.Close()
+
+			workspaceID := 2345678
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.GetProjectUsers(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
+
+func TestAddProjectUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			projectUser *ProjectUser
+			err         error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/project_users/add_project_user_200_ok.json",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: &ProjectUser{
+					ID:              track.Ptr(4567890),
+					ProjectID:       track.Ptr(3456789),
+					UserID:          track.Ptr(5678901),
+					WorkspaceID:     track.Ptr(2345678),
+					Manager:         track.Ptr(true),
+					Rate:            track.Ptr(100),
+					RateLastUpdated: track.Ptr("2022-01-02T03:04:05.000Z"),
+					LaborCost:       track.Ptr(50),
+					At:              track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/project_users/add_project_user_400_bad_request.json",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "User is already a member of the project",
+					Body:       "\"User is already a member of the project\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/project_users/add_project_user_401_unauthorized",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/project_users/add_project_user_403_forbidden",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			projectUser, err := apiClient.AddProjectUser(context.Background(), workspaceID, &AddProjectUserRequestBody{ProjectID: track.Ptr(3456789), UserID: track.Ptr(4567890)})
+
+			if !reflect.DeepEqual(projectUser, tt.out.projectUser) {
+				internal.Errorf(t, projectUser, tt.out.projectUser)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestAddProjectUserRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *AddProjectUserRequestBody
+		out  string
+	}{
+		{
+			name: "int",
+			in: &AddProjectUserRequestBody{
+				ProjectID: track.Ptr(3456789),
+				UserID:    track.Ptr(5678901),
+			},
+			out: "{\"project_id\":3456789,\"user_id\":5678901}",
+		},
+		{
+			name: "int, bool, and string",
+			in: &AddProjectUserRequestBody{
+				ProjectID:      track.Ptr(3456789),
+				UserID:         track.Ptr(5678901),
+				Manager:        track.Ptr(true),
+				Rate:           track.Ptr(100),
+				RateChangeMode: track.Ptr(RateChangeModeStartToday),
+				LaborCost:      track.Ptr(50),
+			},
+			out: "{\"project_id\":3456789,\"user_id\":5678901,\"manager\":true,\"rate\":100,\"rate_change_mode\":\"start-today\",\"labor_cost\":50}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 2345678
+			_, _ = apiClient.AddProjectUser(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
+
+func TestUpdateProjectUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			projectUser *ProjectUser
+			err         error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/project_users/update_project_user_200_ok.json",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: &ProjectUser{
+					ID:              track.Ptr(4567890),
+					ProjectID:       track.Ptr(3456789),
+					UserID:          track.Ptr(5678901),
+					WorkspaceID:     track.Ptr(2345678),
+					Manager:         track.Ptr(true),
+					Rate:            track.Ptr(100),
+					RateLastUpdated: track.Ptr("2022-01-02T03:04:05.000Z"),
+					LaborCost:       track.Ptr(50),
+					At:              track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/project_users/update_project_user_400_bad_request.json",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/project_users/update_project_user_401_unauthorized",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/project_users/update_project_user_403_forbidden",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/project_users/update_project_user_404_not_found.json",
+			},
+			out: struct {
+				projectUser *ProjectUser
+				err         error
+			}{
+				projectUser: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			projectUserID := 4567890
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users", strconv.Itoa(projectUserID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			projectUser, err := apiClient.UpdateProjectUser(context.Background(), workspaceID, projectUserID, &UpdateProjectUserRequestBody{})
+
+			if !reflect.DeepEqual(projectUser, tt.out.projectUser) {
+				internal.Errorf(t, projectUser, tt.out.projectUser)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateProjectUserRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateProjectUserRequestBody
+		out  string
+	}{
+		{
+			name: "bool",
+			in: &UpdateProjectUserRequestBody{
+				Manager: track.Ptr(false),
+			},
+			out: "{\"manager\":false}",
+		},
+		{
+			name: "int and string",
+			in: &UpdateProjectUserRequestBody{
+				Rate:                track.NewNullable(120),
+				RateChangeMode:      track.Ptr(RateChangeModeOverrideAll),
+				LaborCost:           track.NewNullable(60),
+				LaborCostChangeMode: track.Ptr(RateChangeModeStartToday),
+			},
+			out: "{\"rate\":120,\"rate_change_mode\":\"override-all\",\"labor_cost\":60,\"labor_cost_change_mode\":\"start-today\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 2345678
+			projectUserID := 4567890
+			_, _ = apiClient.UpdateProjectUser(context.Background(), workspaceID, projectUserID, tt.in)
+		})
+	}
+}
+
+func TestPatchProjectUsers(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			patchResult *PatchResult
+			err         error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/project_users/patch_project_users_200_ok.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{
+					Success: []*int{track.Ptr(123456789), track.Ptr(234567890)},
+					Failure: []*PatchFailure{
+						{
+							ID:      track.Ptr(345678901),
+							Message: track.Ptr("Project user not found"),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/project_users/patch_project_users_400_bad_request.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid patch operation",
+					Body:       "\"Invalid patch operation\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/project_users/patch_project_users_401_unauthorized",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/project_users/patch_project_users_403_forbidden",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectUserIDs := []int{123456789, 234567890, 345678901}
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users", "123456789,234567890,345678901")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			patchResult, err := apiClient.PatchProjectUsers(context.Background(), workspaceID, projectUserIDs, NewProjectUserPatch().Manager(true).Operations())
+
+			if !reflect.DeepEqual(patchResult, tt.out.patchResult) {
+				internal.Errorf(t, patchResult, tt.out.patchResult)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchProjectUsersRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ProjectUserPatch
+		out  string
+	}{
+		{
+			name: "bool",
+			in:   NewProjectUserPatch().Manager(true),
+			out:  "[{\"op\":\"replace\",\"path\":\"/manager\",\"value\":true}]",
+		},
+		{
+			name: "int",
+			in:   NewProjectUserPatch().Rate(100).LaborCost(50),
+			out:  "[{\"op\":\"replace\",\"path\":\"/rate\",\"value\":100},{\"op\":\"replace\",\"path\":\"/labor_cost\",\"value\":50}]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			projectUserIDs := []int{123456789, 234567890}
+			_, _ = apiClient.PatchProjectUsers(context.Background(), workspaceID, projectUserIDs, tt.in.Operations())
+		})
+	}
+}
+
+func TestPatchProjectUsersChunked(t *testing.T) {
+	var mu sync.Mutex
+	var requestedIDs []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requestedIDs = append(requestedIDs, path.Base(r.URL.Path))
+		mu.Unlock()
+
+		patchResult := &PatchResult{}
+		for _, id := range strings.Split(path.Base(r.URL.Path), ",") {
+			n, _ := strconv.Atoi(id)
+			patchResult.Success = append(patchResult.Success, track.Ptr(n))
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(patchResult)
+	}))
+	defer mockServer.Close()
+
+	projectUserIDs := make([]int, 0, 150)
+	for i := 1; i <= 150; i++ {
+		projectUserIDs = append(projectUserIDs, i)
+	}
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	patchResult, err := apiClient.PatchProjectUsers(context.Background(), 1234567, projectUserIDs, NewProjectUserPatch().Manager(true).Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(requestedIDs) != 2 {
+		internal.Errorf(t, len(requestedIDs), 2)
+	}
+	if len(patchResult.Success) != 150 {
+		internal.Errorf(t, len(patchResult.Success), 150)
+	}
+
+	// No request is sent to the collection path for empty IDs.
+	patchResult, err = apiClient.PatchProjectUsers(context.Background(), 1234567, nil, NewProjectUserPatch().Manager(true).Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(requestedIDs) != 2 {
+		internal.Errorf(t, len(requestedIDs), 2)
+	}
+	if !reflect.DeepEqual(patchResult, &PatchResult{}) {
+		internal.Errorf(t, patchResult, &PatchResult{})
+	}
+}
+
+func TestDeleteProjectUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/project_users/delete_project_user_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/project_users/delete_project_user_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "We're expecting an integer as part of the url for project_user_id",
+					Body:       "\"We're expecting an integer as part of the url for project_user_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/project_users/delete_project_user_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/project_users/delete_project_user_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/project_users/delete_project_user_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			projectUserID := 4567890
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "project_users", strconv.Itoa(projectUserID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.DeleteProjectUser(context.Background(), workspaceID, projectUserID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
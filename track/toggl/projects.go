@@ -2,11 +2,14 @@ package toggl
 
 import (
 	"context"
+	"iter"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Project represents the properties of a project.
@@ -60,14 +63,23 @@ type currentPeriod struct {
 // GetProjectsQuery represents the additional parameters of GetProjects.
 // Currently user_ids, client_ids, and group_ids are not supported.
 type GetProjectsQuery struct {
-	Active        *bool   `url:"active,omitempty"`
-	Since         *int    `url:"since,omitempty"`
-	Billable      *bool   `url:"billable,omitempty"`
-	Name          *string `url:"name,omitempty"`
-	Page          *int    `url:"page,omitempty"`
-	SortField     *string `url:"sort_field,omitempty"`
-	SortOrder     *string `url:"sort_order,omitempty"`
-	OnlyTemplates *bool   `url:"only_templates,omitempty"`
+	Active        *bool      `url:"active,omitempty"`
+	Since         *int       `url:"since,omitempty"`
+	Billable      *bool      `url:"billable,omitempty"`
+	Name          *string    `url:"name,omitempty"`
+	Page          *int       `url:"page,omitempty"`
+	PerPage       *int       `url:"per_page,omitempty"`
+	SortField     *SortField `url:"sort_field,omitempty"`
+	SortOrder     *SortOrder `url:"sort_order,omitempty"`
+	OnlyTemplates *bool      `url:"only_templates,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetProjectsQuery) Validate() error {
+	v := internal.NewValidator(q)
+	v.Positive(q.Page, "page")
+	v.Positive(q.PerPage, "per_page")
+	return v.Err()
 }
 
 // GetProjects gets projects for given workspace.
@@ -80,11 +92,54 @@ func (c *APIClient) GetProjects(ctx context.Context, workspaceID int, query *Get
 	return projects, nil
 }
 
+// AllProjects returns an iterator over projects for given workspace across all pages.
+// The iteration starts from query.Page if it's specified, and stops at the first error, including the cancellation of ctx.
+func (c *APIClient) AllProjects(ctx context.Context, workspaceID int, query *GetProjectsQuery) iter.Seq2[*Project, error] {
+	return func(yield func(*Project, error) bool) {
+		q := GetProjectsQuery{}
+		if query != nil {
+			q = *query
+		}
+		if q.PerPage == nil {
+			q.PerPage = track.Ptr(iteratorPerPage)
+		}
+		page := 1
+		if q.Page != nil {
+			page = *q.Page
+		}
+		for ; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, errors.Wrap(err, "failed to iterate projects"))
+				return
+			}
+			q.Page = track.Ptr(page)
+			projects, err := c.GetProjects(ctx, workspaceID, &q)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, project := range projects {
+				if !yield(project, nil) {
+					return
+				}
+			}
+			if len(projects) < *q.PerPage {
+				return
+			}
+		}
+	}
+}
+
 // GetProjectQuery represents the additional parameters of GetProject.
 type GetProjectQuery struct {
 	WithFirstTimeEntry *bool `url:"with_first_time_entry,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetProjectQuery) Validate() error {
+	return internal.NewValidator(q).Err()
+}
+
 // GetProject gets project for given workspace.
 func (c *APIClient) GetProject(ctx context.Context, workspaceID, projectID int, query *GetProjectQuery) (*Project, error) {
 	var project *Project
@@ -112,13 +167,20 @@ type CreateProjectRequestBody struct {
 	Name                *string              `json:"name,omitempty"`
 	PostedFields        []*string            `json:"postedFields,omitempty"`
 	Rate                *int                 `json:"rate,omitempty"`
-	RateChangeMode      *string              `json:"rate_change_mode,omitempty"`
+	RateChangeMode      *RateChangeMode      `json:"rate_change_mode,omitempty"`
 	Recurring           *bool                `json:"recurring,omitempty"`
 	RecurringParameters *recurringParameters `json:"recurring_parameters,omitempty"`
 	Template            *bool                `json:"template,omitempty"`
 	TemplateID          *int                 `json:"template_id,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateProjectRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
 // CreateProject creates project for given workspace.
 func (c *APIClient) CreateProject(ctx context.Context, workspaceID int, reqBody *CreateProjectRequestBody) (*Project, error) {
 	var project *Project
@@ -129,6 +191,132 @@ func (c *APIClient) CreateProject(ctx context.Context, workspaceID int, reqBody
 	return project, nil
 }
 
+// UpdateProjectRequestBody represents a request body of UpdateProject.
+type UpdateProjectRequestBody struct {
+	Active              *bool                  `json:"active,omitempty"`
+	AutoEstimates       *bool                  `json:"auto_estimates,omitempty"`
+	Billable            *bool                  `json:"billable,omitempty"`
+	CID                 track.Nullable[int]    `json:"cid,omitempty"`
+	ClientID            track.Nullable[int]    `json:"client_id,omitempty"`
+	ClientName          *string                `json:"client_name,omitempty"`
+	Color               *string                `json:"color,omitempty"`
+	Currency            *string                `json:"currency,omitempty"`
+	EstimatedHours      track.Nullable[int]    `json:"estimated_hours,omitempty"`
+	FixedFee            track.Nullable[int]    `json:"fixed_fee,omitempty"`
+	ForeignID           track.Nullable[string] `json:"foreign_id,omitempty"`
+	IsPrivate           *bool                  `json:"is_private,omitempty"`
+	Name                *string                `json:"name,omitempty"`
+	PostedFields        []*string              `json:"postedFields,omitempty"`
+	Rate                track.Nullable[int]    `json:"rate,omitempty"`
+	RateChangeMode      *RateChangeMode        `json:"rate_change_mode,omitempty"`
+	Recurring           *bool                  `json:"recurring,omitempty"`
+	RecurringParameters *recurringParameters   `json:"recurring_parameters,omitempty"`
+	Template            *bool                  `json:"template,omitempty"`
+	TemplateID          *int                   `json:"template_id,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateProjectRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
+}
+
+// UpdateProject updates project for given workspace.
+func (c *APIClient) UpdateProject(ctx context.Context, workspaceID, projectID int, reqBody *UpdateProjectRequestBody) (*Project, error) {
+	var project *Project
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &project); err != nil {
+		return nil, errors.Wrap(err, "failed to update project")
+	}
+	return project, nil
+}
+
+// maxPatchProjectIDs is the maximum number of projects which can be patched in a request.
+const maxPatchProjectIDs = 100
+
+// ProjectPatch builds operations of PatchProjects.
+type ProjectPatch struct {
+	operations []*PatchOperation
+}
+
+// NewProjectPatch creates an empty ProjectPatch.
+func NewProjectPatch() *ProjectPatch {
+	return &ProjectPatch{}
+}
+
+func (p *ProjectPatch) replace(field string, value any) *ProjectPatch {
+	p.operations = append(p.operations, &PatchOperation{Op: PatchOpReplace, Path: "/" + field, Value: value})
+	return p
+}
+
+// Active sets whether projects are active. Set false to archive projects.
+func (p *ProjectPatch) Active(active bool) *ProjectPatch {
+	return p.replace("active", active)
+}
+
+// AutoEstimates sets whether estimates are calculated based on task estimations.
+func (p *ProjectPatch) AutoEstimates(autoEstimates bool) *ProjectPatch {
+	return p.replace("auto_estimates", autoEstimates)
+}
+
+// Billable sets whether projects are billable.
+func (p *ProjectPatch) Billable(billable bool) *ProjectPatch {
+	return p.replace("billable", billable)
+}
+
+// ClientID sets the client of projects.
+func (p *ProjectPatch) ClientID(clientID int) *ProjectPatch {
+	return p.replace("client_id", clientID)
+}
+
+// Color sets the color of projects.
+func (p *ProjectPatch) Color(color string) *ProjectPatch {
+	return p.replace("color", color)
+}
+
+// Currency sets the currency of projects.
+func (p *ProjectPatch) Currency(currency string) *ProjectPatch {
+	return p.replace("currency", currency)
+}
+
+// EstimatedHours sets the estimated hours of projects.
+func (p *ProjectPatch) EstimatedHours(estimatedHours int) *ProjectPatch {
+	return p.replace("estimated_hours", estimatedHours)
+}
+
+// IsPrivate sets whether projects are private.
+func (p *ProjectPatch) IsPrivate(isPrivate bool) *ProjectPatch {
+	return p.replace("is_private", isPrivate)
+}
+
+// Rate sets the hourly rate of projects.
+func (p *ProjectPatch) Rate(rate int) *ProjectPatch {
+	return p.replace("rate", rate)
+}
+
+// Template sets whether projects are templates.
+func (p *ProjectPatch) Template(template bool) *ProjectPatch {
+	return p.replace("template", template)
+}
+
+// Operations returns the operations built so far.
+func (p *ProjectPatch) Operations() []*PatchOperation {
+	return p.operations
+}
+
+// PatchProjects applies the operations to projects for given workspace.
+// Since the number of projects in a request is limited, projectIDs are split into several requests if necessary,
+// and their results are merged. If a request fails, the results of the preceding requests are returned with the error.
+func (c *APIClient) PatchProjects(ctx context.Context, workspaceID int, projectIDs []int, operations []*PatchOperation) (*PatchResult, error) {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects")
+	patchResult, err := c.patchInChunks(ctx, apiSpecificPath, projectIDs, maxPatchProjectIDs, operations)
+	if err != nil {
+		return patchResult, errors.Wrap(err, "failed to patch projects")
+	}
+	return patchResult, nil
+}
+
 // DeleteProject deletes project for given workspace.
 func (c *APIClient) DeleteProject(ctx context.Context, workspaceID, projectID int) error {
 	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID))
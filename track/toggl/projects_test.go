@@ -2,11 +2,16 @@ package toggl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -110,14 +115,10 @@ func TestGetProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid workspace_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid workspace_id",
+					Body:       "\"Missing or invalid workspace_id\"\n",
 				},
 			},
 		},
@@ -135,13 +136,10 @@ func TestGetProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -159,13 +157,10 @@ func TestGetProjects(t *testing.T) {
 				err      error
 			}{
 				projects: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -184,10 +179,10 @@ func TestGetProjects(t *testing.T) {
 				internal.Errorf(t, projects, tt.out.projects)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -310,14 +305,10 @@ func TestGetProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"We're expecting an integer as part of the url for project_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"63"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "We're expecting an integer as part of the url for project_id",
+					Body:       "\"We're expecting an integer as part of the url for project_id\"\n",
 				},
 			},
 		},
@@ -335,13 +326,10 @@ func TestGetProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -359,13 +347,10 @@ func TestGetProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -385,10 +370,10 @@ func TestGetProject(t *testing.T) {
 				internal.Errorf(t, project, tt.out.project)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -501,14 +486,10 @@ func TestCreateProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -526,13 +507,10 @@ func TestCreateProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -550,13 +528,10 @@ func TestCreateProject(t *testing.T) {
 				err     error
 			}{
 				project: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -569,16 +544,16 @@ func TestCreateProject(t *testing.T) {
 			defer mockServer.Close()
 
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
-			project, err := apiClient.CreateProject(context.Background(), workspaceID, &CreateProjectRequestBody{})
+			project, err := apiClient.CreateProject(context.Background(), workspaceID, &CreateProjectRequestBody{Name: track.Ptr("project")})
 
 			if !reflect.DeepEqual(project, tt.out.project) {
 				internal.Errorf(t, project, tt.out.project)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -631,6 +606,408 @@ func TestCreateProjectRequestBody(t *testing.T) {
 	}
 }
 
+func TestUpdateProject(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			project *Project
+			err     error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/projects/update_project_200_ok.json",
+			},
+			out: struct {
+				project *Project
+				err     error
+			}{
+				project: &Project{
+					ID:             track.Ptr(123456789),
+					WorkspaceID:    track.Ptr(1234567),
+					Name:           track.Ptr("MyRenamedProject"),
+					IsPrivate:      track.Ptr(false),
+					Active:         track.Ptr(false),
+					At:             track.Ptr(time.Date(2021, time.February, 3, 4, 5, 6, 0, time.Local)),
+					Color:          track.Ptr("#0a1b2c"),
+					EstimatedHours: track.Ptr(10),
+					Recurring:      track.Ptr(false),
+					WID:            track.Ptr(1234567),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/projects/update_project_400_bad_request.json",
+			},
+			out: struct {
+				project *Project
+				err     error
+			}{
+				project: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/projects/update_project_401_unauthorized",
+			},
+			out: struct {
+				project *Project
+				err     error
+			}{
+				project: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/projects/update_project_403_forbidden",
+			},
+			out: struct {
+				project *Project
+				err     error
+			}{
+				project: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/projects/update_project_404_not_found.json",
+			},
+			out: struct {
+				project *Project
+				err     error
+			}{
+				project: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			project, err := apiClient.UpdateProject(context.Background(), workspaceID, projectID, &UpdateProjectRequestBody{})
+
+			if !reflect.DeepEqual(project, tt.out.project) {
+				internal.Errorf(t, project, tt.out.project)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateProjectRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateProjectRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &UpdateProjectRequestBody{
+				Name: track.Ptr("MyRenamedProject"),
+			},
+			out: "{\"name\":\"MyRenamedProject\"}",
+		},
+		{
+			name: "bool and int",
+			in: &UpdateProjectRequestBody{
+				Active:         track.Ptr(false),
+				EstimatedHours: track.NewNullable(10),
+			},
+			out: "{\"active\":false,\"estimated_hours\":10}",
+		}, {
+			name: "null",
+			in: &UpdateProjectRequestBody{
+				ClientID: track.Null[int](),
+				Rate:     track.Null[int](),
+			},
+			out: "{\"client_id\":null,\"rate\":null}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			projectID := 123456789
+			_, _ = apiClient.UpdateProject(context.Background(), workspaceID, projectID, tt.in)
+		})
+	}
+}
+
+func TestPatchProjects(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			patchResult *PatchResult
+			err         error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/projects/patch_projects_200_ok.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{
+					Success: []*int{track.Ptr(123456789), track.Ptr(234567890)},
+					Failure: []*PatchFailure{
+						{
+							ID:      track.Ptr(345678901),
+							Message: track.Ptr("Project not found"),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/projects/patch_projects_400_bad_request.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid patch operation",
+					Body:       "\"Invalid patch operation\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/projects/patch_projects_401_unauthorized",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/projects/patch_projects_403_forbidden",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectIDs := []int{123456789, 234567890, 345678901}
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", "123456789,234567890,345678901")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			patchResult, err := apiClient.PatchProjects(context.Background(), workspaceID, projectIDs, NewProjectPatch().Active(false).Operations())
+
+			if !reflect.DeepEqual(patchResult, tt.out.patchResult) {
+				internal.Errorf(t, patchResult, tt.out.patchResult)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchProjectsRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *ProjectPatch
+		out  string
+	}{
+		{
+			name: "archive",
+			in:   NewProjectPatch().Active(false),
+			out:  "[{\"op\":\"replace\",\"path\":\"/active\",\"value\":false}]",
+		},
+		{
+			name: "bool, int, and string",
+			in:   NewProjectPatch().Billable(true).Rate(100).Color("#abcdef"),
+			out:  "[{\"op\":\"replace\",\"path\":\"/billable\",\"value\":true},{\"op\":\"replace\",\"path\":\"/rate\",\"value\":100},{\"op\":\"replace\",\"path\":\"/color\",\"value\":\"#abcdef\"}]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			projectIDs := []int{123456789, 234567890}
+			_, _ = apiClient.PatchProjects(context.Background(), workspaceID, projectIDs, tt.in.Operations())
+		})
+	}
+}
+
+func TestPatchProjectsChunked(t *testing.T) {
+	var mu sync.Mutex
+	var requestedIDs []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requestedIDs = append(requestedIDs, path.Base(r.URL.Path))
+		mu.Unlock()
+
+		patchResult := &PatchResult{}
+		for _, id := range strings.Split(path.Base(r.URL.Path), ",") {
+			n, _ := strconv.Atoi(id)
+			patchResult.Success = append(patchResult.Success, track.Ptr(n))
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(patchResult)
+	}))
+	defer mockServer.Close()
+
+	projectIDs := make([]int, 0, 150)
+	for i := 1; i <= 150; i++ {
+		projectIDs = append(projectIDs, i)
+	}
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	patchResult, err := apiClient.PatchProjects(context.Background(), 1234567, projectIDs, NewProjectPatch().Active(false).Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(requestedIDs) != 2 {
+		internal.Errorf(t, len(requestedIDs), 2)
+	}
+	if len(patchResult.Success) != 150 {
+		internal.Errorf(t, len(patchResult.Success), 150)
+	}
+
+	// No request is sent to the collection path for empty IDs.
+	patchResult, err = apiClient.PatchProjects(context.Background(), 1234567, nil, NewProjectPatch().Active(false).Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(requestedIDs) != 2 {
+		internal.Errorf(t, len(requestedIDs), 2)
+	}
+	if !reflect.DeepEqual(patchResult, &PatchResult{}) {
+		internal.Errorf(t, patchResult, &PatchResult{})
+	}
+}
+
 func TestDeleteProject(t *testing.T) {
 	tests := []struct {
 		name string
@@ -669,14 +1046,10 @@ func TestDeleteProject(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"We're expecting an integer as part of the url for project_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"63"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "We're expecting an integer as part of the url for project_id",
+					Body:       "\"We're expecting an integer as part of the url for project_id\"\n",
 				},
 			},
 		},
@@ -692,13 +1065,10 @@ func TestDeleteProject(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -714,13 +1084,10 @@ func TestDeleteProject(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -736,14 +1103,10 @@ func TestDeleteProject(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Resource can not be found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"28"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
 				},
 			},
 		},
@@ -759,10 +1122,10 @@ func TestDeleteProject(t *testing.T) {
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
 			err := apiClient.DeleteProject(context.Background(), workspaceID, projectID)
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -772,3 +1135,143 @@ func TestDeleteProject(t *testing.T) {
 		})
 	}
 }
+
+func TestAllProjects(t *testing.T) {
+	// Projects 1 to 5 are served per_page per page.
+	var requestedPages []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+		var projects []*Project
+		for id := perPage*(page-1) + 1; id <= perPage*page && id <= 5; id++ {
+			projects = append(projects, &Project{ID: track.Ptr(id)})
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(projects)
+	}))
+	defer mockServer.Close()
+
+	tests := []struct {
+		name string
+		in   struct {
+			query *GetProjectsQuery
+			limit int
+		}
+		out struct {
+			projectIDs     []int
+			requestedPages []string
+		}
+	}{
+		{
+			name: "all pages",
+			in: struct {
+				query *GetProjectsQuery
+				limit int
+			}{query: nil, limit: -1},
+			out: struct {
+				projectIDs     []int
+				requestedPages []string
+			}{projectIDs: []int{1, 2, 3, 4, 5}, requestedPages: []string{"1"}},
+		},
+		{
+			name: "stop at the short page",
+			in: struct {
+				query *GetProjectsQuery
+				limit int
+			}{query: &GetProjectsQuery{PerPage: track.Ptr(2)}, limit: -1},
+			out: struct {
+				projectIDs     []int
+				requestedPages []string
+			}{projectIDs: []int{1, 2, 3, 4, 5}, requestedPages: []string{"1", "2", "3"}},
+		},
+		{
+			name: "stop at the empty page after the full page",
+			in: struct {
+				query *GetProjectsQuery
+				limit int
+			}{query: &GetProjectsQuery{PerPage: track.Ptr(5)}, limit: -1},
+			out: struct {
+				projectIDs     []int
+				requestedPages []string
+			}{projectIDs: []int{1, 2, 3, 4, 5}, requestedPages: []string{"1", "2"}},
+		},
+		{
+			name: "start from page 2",
+			in: struct {
+				query *GetProjectsQuery
+				limit int
+			}{query: &GetProjectsQuery{Page: track.Ptr(2), PerPage: track.Ptr(2)}, limit: -1},
+			out: struct {
+				projectIDs     []int
+				requestedPages []string
+			}{projectIDs: []int{3, 4, 5}, requestedPages: []string{"2", "3"}},
+		},
+		{
+			name: "break",
+			in: struct {
+				query *GetProjectsQuery
+				limit int
+			}{query: nil, limit: 1},
+			out: struct {
+				projectIDs     []int
+				requestedPages []string
+			}{projectIDs: []int{1}, requestedPages: []string{"1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPages = nil
+			workspaceID := 1234567
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+			var projectIDs []int
+			for project, err := range apiClient.AllProjects(context.Background(), workspaceID, tt.in.query) {
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+				projectIDs = append(projectIDs, *project.ID)
+				if len(projectIDs) == tt.in.limit {
+					break
+				}
+			}
+
+			if !reflect.DeepEqual(projectIDs, tt.out.projectIDs) {
+				internal.Errorf(t, projectIDs, tt.out.projectIDs)
+			}
+			if !reflect.DeepEqual(requestedPages, tt.out.requestedPages) {
+				internal.Errorf(t, requestedPages, tt.out.requestedPages)
+			}
+		})
+	}
+}
+
+func TestAllProjectsCanceled(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	}))
+	defer mockServer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+
+	count := 0
+	var lastErr error
+	for _, err := range apiClient.AllProjects(ctx, 1234567, &GetProjectsQuery{PerPage: track.Ptr(2)}) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+		cancel()
+	}
+
+	if count != 2 {
+		internal.Errorf(t, count, 2)
+	}
+	if !errors.Is(lastErr, context.Canceled) {
+		internal.Errorf(t, lastErr, context.Canceled)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Tag represents the properties of a tag.
@@ -34,6 +35,13 @@ type CreateTagRequestBody struct {
 	WorkspaceID *int    `json:"workspace_id,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateTagRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
 // CreateTag creates workspace tags.
 func (c *APIClient) CreateTag(ctx context.Context, workspaceID int, reqBody *CreateTagRequestBody) (*Tag, error) {
 	var tag *Tag
@@ -50,6 +58,13 @@ type UpdateTagRequestBody struct {
 	WorkspaceID *int    `json:"workspace_id,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateTagRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
+}
+
 // UpdateTag updates workspace tags.
 func (c *APIClient) UpdateTag(ctx context.Context, workspaceID, tagID int, reqBody *UpdateTagRequestBody) (*Tag, error) {
 	var tag *Tag
@@ -64,14 +64,10 @@ func TestGetTags(t *testing.T) {
 				err  error
 			}{
 				tags: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid workspace_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid workspace_id",
+					Body:       "\"Missing or invalid workspace_id\"\n",
 				},
 			},
 		},
@@ -89,13 +85,10 @@ func TestGetTags(t *testing.T) {
 				err  error
 			}{
 				tags: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -113,13 +106,10 @@ func TestGetTags(t *testing.T) {
 				err  error
 			}{
 				tags: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -138,10 +128,10 @@ func TestGetTags(t *testing.T) {
 				internal.Errorf(t, tags, tt.out.tags)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -200,14 +190,10 @@ func TestCreateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"tag name can't be blank\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"26"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "tag name can't be blank",
+					Body:       "\"tag name can't be blank\"\n",
 				},
 			},
 		},
@@ -225,13 +211,10 @@ func TestCreateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -249,13 +232,10 @@ func TestCreateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -268,16 +248,16 @@ func TestCreateTag(t *testing.T) {
 			defer mockServer.Close()
 
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
-			tag, err := apiClient.CreateTag(context.Background(), workspaceID, &CreateTagRequestBody{})
+			tag, err := apiClient.CreateTag(context.Background(), workspaceID, &CreateTagRequestBody{Name: track.Ptr("tag")})
 
 			if !reflect.DeepEqual(tag, tt.out.tag) {
 				internal.Errorf(t, tag, tt.out.tag)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -369,14 +349,10 @@ func TestUpdateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"tag name can't be blank\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"26"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "tag name can't be blank",
+					Body:       "\"tag name can't be blank\"\n",
 				},
 			},
 		},
@@ -394,13 +370,10 @@ func TestUpdateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -418,13 +391,10 @@ func TestUpdateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -442,14 +412,10 @@ func TestUpdateTag(t *testing.T) {
 				err error
 			}{
 				tag: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Tag was not found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Tag was not found",
+					Body:       "\"Tag was not found\"\n",
 				},
 			},
 		},
@@ -469,10 +435,10 @@ func TestUpdateTag(t *testing.T) {
 				internal.Errorf(t, tag, tt.out.tag)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -555,14 +521,10 @@ func TestDeleteTag(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"We're expecting an integer as part of the url for tag_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"59"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "We're expecting an integer as part of the url for tag_id",
+					Body:       "\"We're expecting an integer as part of the url for tag_id\"\n",
 				},
 			},
 		},
@@ -578,13 +540,10 @@ func TestDeleteTag(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -600,13 +559,10 @@ func TestDeleteTag(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -622,14 +578,10 @@ func TestDeleteTag(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Tag was not found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Tag was not found",
+					Body:       "\"Tag was not found\"\n",
 				},
 			},
 		},
@@ -645,10 +597,10 @@ func TestDeleteTag(t *testing.T) {
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
 			err := apiClient.DeleteTag(context.Background(), workspaceID, tagID)
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -0,0 +1,134 @@
+package toggl
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+// Task represents the properties of a task.
+type Task struct {
+	ID                  *int       `json:"id,omitempty"`
+	Name                *string    `json:"name,omitempty"`
+	WorkspaceID         *int       `json:"workspace_id,omitempty"`
+	ProjectID           *int       `json:"project_id,omitempty"`
+	UserID              *int       `json:"user_id,omitempty"`
+	Recurring           *bool      `json:"recurring,omitempty"`
+	Active              *bool      `json:"active,omitempty"`
+	At                  *time.Time `json:"at,omitempty"`
+	ServerDeletedAt     *time.Time `json:"server_deleted_at,omitempty"`
+	EstimatedSeconds    *int       `json:"estimated_seconds,omitempty"`
+	TrackedSeconds      *int       `json:"tracked_seconds,omitempty"`
+	Rate                *int       `json:"rate,omitempty"`
+	RateLastUpdated     *string    `json:"rate_last_updated,omitempty"`
+	TogglAccountsID     *string    `json:"toggl_accounts_id,omitempty"`
+	IntegrationExtID    *string    `json:"integration_ext_id,omitempty"`
+	IntegrationExtType  *string    `json:"integration_ext_type,omitempty"`
+	IntegrationProvider *string    `json:"integration_provider,omitempty"`
+}
+
+// GetTasksQuery represents the additional parameters of GetTasks.
+type GetTasksQuery struct {
+	Active    *bool      `url:"active,omitempty"`
+	Since     *int       `url:"since,omitempty"`
+	Page      *int       `url:"page,omitempty"`
+	PerPage   *int       `url:"per_page,omitempty"`
+	SortField *SortField `url:"sort_field,omitempty"`
+	SortOrder *SortOrder `url:"sort_order,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetTasksQuery) Validate() error {
+	v := internal.NewValidator(q)
+	v.Positive(q.Page, "page")
+	v.Positive(q.PerPage, "per_page")
+	return v.Err()
+}
+
+// GetTasks gets tasks for given project.
+func (c *APIClient) GetTasks(ctx context.Context, workspaceID, projectID int, query *GetTasksQuery) ([]*Task, error) {
+	var tasks []*Task
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks")
+	if err := c.httpGet(ctx, apiSpecificPath, query, &tasks); err != nil {
+		return nil, errors.Wrap(err, "failed to get tasks")
+	}
+	return tasks, nil
+}
+
+// GetTask gets task for given project.
+func (c *APIClient) GetTask(ctx context.Context, workspaceID, projectID, taskID int) (*Task, error) {
+	var task *Task
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+	if err := c.httpGet(ctx, apiSpecificPath, nil, &task); err != nil {
+		return nil, errors.Wrap(err, "failed to get task")
+	}
+	return task, nil
+}
+
+// CreateTaskRequestBody represents a request body of CreateTask.
+type CreateTaskRequestBody struct {
+	Active           *bool   `json:"active,omitempty"`
+	EstimatedSeconds *int    `json:"estimated_seconds,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ProjectID        *int    `json:"project_id,omitempty"`
+	UserID           *int    `json:"user_id,omitempty"`
+	WorkspaceID      *int    `json:"workspace_id,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateTaskRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
+// CreateTask creates a new task for given project.
+func (c *APIClient) CreateTask(ctx context.Context, workspaceID, projectID int, reqBody *CreateTaskRequestBody) (*Task, error) {
+	var task *Task
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &task); err != nil {
+		return nil, errors.Wrap(err, "failed to create task")
+	}
+	return task, nil
+}
+
+// UpdateTaskRequestBody represents a request body of UpdateTask.
+type UpdateTaskRequestBody struct {
+	Active           *bool               `json:"active,omitempty"`
+	EstimatedSeconds track.Nullable[int] `json:"estimated_seconds,omitempty"`
+	Name             *string             `json:"name,omitempty"`
+	ProjectID        *int                `json:"project_id,omitempty"`
+	UserID           track.Nullable[int] `json:"user_id,omitempty"`
+	WorkspaceID      *int                `json:"workspace_id,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateTaskRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
+}
+
+// UpdateTask updates a task for given project.
+func (c *APIClient) UpdateTask(ctx context.Context, workspaceID, projectID, taskID int, reqBody *UpdateTaskRequestBody) (*Task, error) {
+	var task *Task
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &task); err != nil {
+		return nil, errors.Wrap(err, "failed to update task")
+	}
+	return task, nil
+}
+
+// DeleteTask deletes a task for given project.
+func (c *APIClient) DeleteTask(ctx context.Context, workspaceID, projectID, taskID int) error {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete task")
+	}
+	return nil
+}
@@ -0,0 +1,845 @@
+package toggl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestGetTasks(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			tasks []*Task
+			err   error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/tasks/get_tasks_200_ok.json",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: []*Task{
+					{
+						ID:               track.Ptr(12345678),
+						Name:             track.Ptr("MyTask"),
+						WorkspaceID:      track.Ptr(1234567),
+						ProjectID:        track.Ptr(123456789),
+						Recurring:        track.Ptr(false),
+						Active:           track.Ptr(true),
+						At:               track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+						EstimatedSeconds: track.Ptr(3600),
+						TrackedSeconds:   track.Ptr(1800),
+					},
+					{
+						ID:               track.Ptr(23456789),
+						Name:             track.Ptr("AnotherTask"),
+						WorkspaceID:      track.Ptr(1234567),
+						ProjectID:        track.Ptr(123456789),
+						UserID:           track.Ptr(2345678),
+						Recurring:        track.Ptr(false),
+						Active:           track.Ptr(false),
+						At:               track.Ptr(time.Date(2022, time.February, 3, 4, 5, 6, 0, time.Local)),
+						EstimatedSeconds: track.Ptr(0),
+						TrackedSeconds:   track.Ptr(0),
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/tasks/get_tasks_400_bad_request.json",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Missing or invalid project_id",
+					Body:       "\"Missing or invalid project_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/tasks/get_tasks_401_unauthorized",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/tasks/get_tasks_403_forbidden",
+			},
+			out: struct {
+				tasks []*Task
+				err   error
+			}{
+				tasks: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			tasks, err := apiClient.GetTasks(context.Background(), workspaceID, projectID, &GetTasksQuery{})
+
+			if !reflect.DeepEqual(tasks, tt.out.tasks) {
+				internal.Errorf(t, tasks, tt.out.tasks)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetTasksQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetTasksQuery
+		out  string
+	}{
+		{
+			name: "GetTasksQuery is nil",
+			in:   nil,
+			out:  "",
+		},
+		{
+			name: "active=true",
+			in:   &GetTasksQuery{Active: track.Ptr(true)},
+			out:  "active=true",
+		},
+		{
+			name: "active=false&since=1640995200",
+			in:   &GetTasksQuery{Active: track.Ptr(false), Since: track.Ptr(1640995200)},
+			out:  "active=false&since=1640995200",
+		},
+		{
+			name: "page=2&per_page=50",
+			in:   &GetTasksQuery{Page: track.Ptr(2), PerPage: track.Ptr(50)},
+			out:  "page=2&per_page=50",
+		},
+		{
+			name: "GetTasksQuery is empty",
+			in:   &GetTasksQuery{},
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertQuery(t, tt.out)
+			defer mockServer.Close()
+
+			workspaceID := 1234567
+			projectID := 123456789
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			_, _ = apiClient.GetTasks(context.Background(), workspaceID, projectID, tt.in)
+		})
+	}
+}
+
+func TestGetTask(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			task *Task
+			err  error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/tasks/get_task_200_ok.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: &Task{
+					ID:               track.Ptr(12345678),
+					Name:             track.Ptr("MyTask"),
+					WorkspaceID:      track.Ptr(1234567),
+					ProjectID:        track.Ptr(123456789),
+					Recurring:        track.Ptr(false),
+					Active:           track.Ptr(true),
+					At:               track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					EstimatedSeconds: track.Ptr(3600),
+					TrackedSeconds:   track.Ptr(1800),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/tasks/get_task_400_bad_request.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "We're expecting an integer as part of the url for task_id",
+					Body:       "\"We're expecting an integer as part of the url for task_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/tasks/get_task_401_unauthorized",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/tasks/get_task_403_forbidden",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/tasks/get_task_404_not_found.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			taskID := 12345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			task, err := apiClient.GetTask(context.Background(), workspaceID, projectID, taskID)
+
+			if !reflect.DeepEqual(task, tt.out.task) {
+				internal.Errorf(t, task, tt.out.task)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateTask(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			task *Task
+			err  error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/tasks/create_task_200_ok.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: &Task{
+					ID:               track.Ptr(12345678),
+					Name:             track.Ptr("MyTask"),
+					WorkspaceID:      track.Ptr(1234567),
+					ProjectID:        track.Ptr(123456789),
+					Recurring:        track.Ptr(false),
+					Active:           track.Ptr(true),
+					At:               track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					EstimatedSeconds: track.Ptr(3600),
+					TrackedSeconds:   track.Ptr(1800),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/tasks/create_task_400_bad_request.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/tasks/create_task_401_unauthorized",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/tasks/create_task_403_forbidden",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			task, err := apiClient.CreateTask(context.Background(), workspaceID, projectID, &CreateTaskRequestBody{Name: track.Ptr("task")})
+
+			if !reflect.DeepEqual(task, tt.out.task) {
+				internal.Errorf(t, task, tt.out.task)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateTaskRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateTaskRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &CreateTaskRequestBody{
+				Name: track.Ptr("MyTask"),
+			},
+			out: "{\"name\":\"MyTask\"}",
+		},
+		{
+			name: "bool, int, and string",
+			in: &CreateTaskRequestBody{
+				Active:           track.Ptr(true),
+				EstimatedSeconds: track.Ptr(3600),
+				Name:             track.Ptr("MyTask"),
+			},
+			out: "{\"active\":true,\"estimated_seconds\":3600,\"name\":\"MyTask\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			projectID := 123456789
+			_, _ = apiClient.CreateTask(context.Background(), workspaceID, projectID, tt.in)
+		})
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			task *Task
+			err  error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/tasks/update_task_200_ok.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: &Task{
+					ID:               track.Ptr(12345678),
+					Name:             track.Ptr("MyTask"),
+					WorkspaceID:      track.Ptr(1234567),
+					ProjectID:        track.Ptr(123456789),
+					Recurring:        track.Ptr(false),
+					Active:           track.Ptr(true),
+					At:               track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+					EstimatedSeconds: track.Ptr(3600),
+					TrackedSeconds:   track.Ptr(1800),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/tasks/update_task_400_bad_request.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/tasks/update_task_401_unauthorized",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/tasks/update_task_403_forbidden",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/tasks/update_task_404_not_found.json",
+			},
+			out: struct {
+				task *Task
+				err  error
+			}{
+				task: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			taskID := 12345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			task, err := apiClient.UpdateTask(context.Background(), workspaceID, projectID, taskID, &UpdateTaskRequestBody{})
+
+			if !reflect.DeepEqual(task, tt.out.task) {
+				internal.Errorf(t, task, tt.out.task)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateTaskRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateTaskRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &UpdateTaskRequestBody{
+				Name: track.Ptr("MyTask"),
+			},
+			out: "{\"name\":\"MyTask\"}",
+		},
+		{
+			name: "bool and int",
+			in: &UpdateTaskRequestBody{
+				Active: track.Ptr(false),
+				UserID: track.NewNullable(2345678),
+			},
+			out: "{\"active\":false,\"user_id\":2345678}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			projectID := 123456789
+			taskID := 12345678
+			_, _ = apiClient.UpdateTask(context.Background(), workspaceID, projectID, taskID, tt.in)
+		})
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/tasks/delete_task_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/tasks/delete_task_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "We're expecting an integer as part of the url for task_id",
+					Body:       "\"We're expecting an integer as part of the url for task_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/tasks/delete_task_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/tasks/delete_task_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/tasks/delete_task_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			projectID := 123456789
+			taskID := 12345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "projects", strconv.Itoa(projectID), "tasks", strconv.Itoa(taskID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.DeleteTask(context.Background(), workspaceID, projectID, taskID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // TimeEntry represents the properties of a time entry.
@@ -32,6 +34,31 @@ type TimeEntry struct {
 	TID             *int       `json:"tid,omitempty"`
 }
 
+// IsRunning reports whether the time entry is running.
+func (t *TimeEntry) IsRunning() bool {
+	return t.Duration != nil && *t.Duration < 0
+}
+
+// Elapsed returns how long the time entry lasts.
+// For a running time entry, it is computed from the start to the current time.
+func (t *TimeEntry) Elapsed() time.Duration {
+	return t.elapsed(time.Now())
+}
+
+func (t *TimeEntry) elapsed(now time.Time) time.Duration {
+	if t.Duration == nil {
+		return 0
+	}
+	if !t.IsRunning() {
+		return time.Duration(*t.Duration) * time.Second
+	}
+	if t.Start != nil {
+		return now.Sub(*t.Start)
+	}
+	// Toggl API v8 represented a running time entry as the negative value of the start in Unix time.
+	return time.Duration(now.Unix()+int64(*t.Duration)) * time.Second
+}
+
 // GetTimeEntriesQuery represents the additional parameters of GetTimeEntries.
 type GetTimeEntriesQuery struct {
 	Before    *string `url:"before,omitempty"`
@@ -40,6 +67,17 @@ type GetTimeEntriesQuery struct {
 	EndDate   *string `url:"end_date,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the query has invalid fields.
+func (q *GetTimeEntriesQuery) Validate() error {
+	v := internal.NewValidator(q)
+	startDate := v.DateOrTime(q.StartDate, "start_date")
+	endDate := v.DateOrTime(q.EndDate, "end_date")
+	v.DateOrTime(q.Before, "before")
+	v.Check((q.StartDate == nil) == (q.EndDate == nil), "end_date", "must be given together with start_date")
+	v.NotBefore(endDate, startDate, "end_date", "start_date")
+	return v.Err()
+}
+
 // GetTimeEntries lists latest time entries.
 func (c *APIClient) GetTimeEntries(ctx context.Context, query *GetTimeEntriesQuery) ([]*TimeEntry, error) {
 	var timeEntries []*TimeEntry
@@ -73,7 +111,7 @@ type CreateTimeEntryRequestBody struct {
 	Start        *time.Time `json:"start,omitempty"`
 	StartDate    *string    `json:"start_date,omitempty"`
 	Stop         *time.Time `json:"stop,omitempty"`
-	TagAction    *string    `json:"tag_action,omitempty"`
+	TagAction    *TagAction `json:"tag_action,omitempty"`
 	TagIDs       []*int     `json:"tag_ids,omitempty"`
 	Tags         []*string  `json:"tags,omitempty"`
 	TaskID       *int       `json:"task_id,omitempty"`
@@ -84,6 +122,17 @@ type CreateTimeEntryRequestBody struct {
 	WorkspaceID  *int       `json:"workspace_id,omitempty"`
 }
 
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateTimeEntryRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.WorkspaceID, "workspace_id")
+	v.Required(r.CreatedWith, "created_with")
+	v.Required(r.Start, "start")
+	v.Date(r.StartDate, "start_date")
+	v.NotBefore(r.Stop, r.Start, "stop", "start")
+	return v.Err()
+}
+
 // CreateTimeEntry creates a new workspace time entry.
 func (c *APIClient) CreateTimeEntry(ctx context.Context, workspaceID int, reqBody *CreateTimeEntryRequestBody) (*TimeEntry, error) {
 	var timeEntry *TimeEntry
@@ -94,28 +143,64 @@ func (c *APIClient) CreateTimeEntry(ctx context.Context, workspaceID int, reqBod
 	return timeEntry, nil
 }
 
+// StartTimeEntry starts a new running time entry.
+// Duration and Stop of reqBody are ignored, and Start defaults to the current time.
+// CreatedWith, which is required by Toggl API v9, defaults to "toggl-go".
+func (c *APIClient) StartTimeEntry(ctx context.Context, workspaceID int, reqBody *CreateTimeEntryRequestBody) (*TimeEntry, error) {
+	startBody := CreateTimeEntryRequestBody{}
+	if reqBody != nil {
+		startBody = *reqBody
+	}
+	startBody.Duration = track.Ptr(-1) // A negative duration means the time entry is running.
+	startBody.Stop = nil
+	if startBody.Start == nil {
+		startBody.Start = track.Ptr(time.Now().UTC().Truncate(time.Second))
+	}
+	if startBody.CreatedWith == nil {
+		startBody.CreatedWith = track.Ptr(createdWith)
+	}
+	if startBody.WorkspaceID == nil {
+		startBody.WorkspaceID = track.Ptr(workspaceID)
+	}
+
+	timeEntry, err := c.CreateTimeEntry(ctx, workspaceID, &startBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start time entry")
+	}
+	return timeEntry, nil
+}
+
 // UpdateTimeEntryRequestBody represents a request body of UpdateTimeEntry.
 type UpdateTimeEntryRequestBody struct {
-	Billable     *bool      `json:"billable,omitempty"`
-	CreatedWith  *string    `json:"created_with,omitempty"`
-	Description  *string    `json:"description,omitempty"`
-	Duration     *int       `json:"duration,omitempty"`
-	Duronly      *bool      `json:"duronly,omitempty"`
-	PID          *int       `json:"pid,omitempty"`
-	PostedFields []*string  `json:"postedFields,omitempty"`
-	ProjectID    *int       `json:"project_id,omitempty"`
-	Start        *time.Time `json:"start,omitempty"`
-	StartDate    *string    `json:"start_date,omitempty"`
-	Stop         *time.Time `json:"stop,omitempty"`
-	TagAction    *string    `json:"tag_action,omitempty"`
-	TagIDs       []*int     `json:"tag_ids,omitempty"`
-	Tags         []*string  `json:"tags,omitempty"`
-	TaskID       *int       `json:"task_id,omitempty"`
-	TID          *int       `json:"tid,omitempty"`
-	UID          *int       `json:"uid,omitempty"`
-	UserID       *int       `json:"user_id,omitempty"`
-	WID          *int       `json:"wid,omitempty"`
-	WorkspaceID  *int       `json:"workspace_id,omitempty"`
+	Billable     *bool               `json:"billable,omitempty"`
+	CreatedWith  *string             `json:"created_with,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	Duration     *int                `json:"duration,omitempty"`
+	Duronly      *bool               `json:"duronly,omitempty"`
+	PID          track.Nullable[int] `json:"pid,omitempty"`
+	PostedFields []*string           `json:"postedFields,omitempty"`
+	ProjectID    track.Nullable[int] `json:"project_id,omitempty"`
+	Start        *time.Time          `json:"start,omitempty"`
+	StartDate    *string             `json:"start_date,omitempty"`
+	Stop         *time.Time          `json:"stop,omitempty"`
+	TagAction    *TagAction          `json:"tag_action,omitempty"`
+	TagIDs       []*int              `json:"tag_ids,omitempty"`
+	Tags         []*string           `json:"tags,omitempty"`
+	TaskID       track.Nullable[int] `json:"task_id,omitempty"`
+	TID          track.Nullable[int] `json:"tid,omitempty"`
+	UID          *int                `json:"uid,omitempty"`
+	UserID       *int                `json:"user_id,omitempty"`
+	WID          *int                `json:"wid,omitempty"`
+	WorkspaceID  *int                `json:"workspace_id,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateTimeEntryRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.CreatedWith, "created_with")
+	v.Date(r.StartDate, "start_date")
+	v.NotBefore(r.Stop, r.Start, "stop", "start")
+	return v.Err()
 }
 
 // UpdateTimeEntry updates a workspace time entry.
@@ -136,3 +221,125 @@ func (c *APIClient) DeleteTimeEntry(ctx context.Context, workspaceID, timeEntryI
 	}
 	return nil
 }
+
+// StopTimeEntry stops a running workspace time entry.
+func (c *APIClient) StopTimeEntry(ctx context.Context, workspaceID, timeEntryID int) (*TimeEntry, error) {
+	var timeEntry *TimeEntry
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "time_entries", strconv.Itoa(timeEntryID), "stop")
+	if err := c.httpPatch(ctx, apiSpecificPath, nil, &timeEntry); err != nil {
+		return nil, errors.Wrap(err, "failed to stop time entry")
+	}
+	return timeEntry, nil
+}
+
+// StopCurrentTimeEntry stops the running time entry of the current user.
+// If no time entry is running, it returns nil without error.
+func (c *APIClient) StopCurrentTimeEntry(ctx context.Context) (*TimeEntry, error) {
+	current, err := c.GetCurrentTimeEntry(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stop current time entry")
+	}
+	if current == nil || current.ID == nil || current.WorkspaceID == nil {
+		return nil, nil
+	}
+	timeEntry, err := c.StopTimeEntry(ctx, *current.WorkspaceID, *current.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stop current time entry")
+	}
+	return timeEntry, nil
+}
+
+// maxPatchTimeEntryIDs is the maximum number of time entries which can be patched in a request.
+const maxPatchTimeEntryIDs = 100
+
+// TimeEntryPatch builds operations of PatchTimeEntries.
+type TimeEntryPatch struct {
+	operations []*PatchOperation
+}
+
+// NewTimeEntryPatch creates an empty TimeEntryPatch.
+func NewTimeEntryPatch() *TimeEntryPatch {
+	return &TimeEntryPatch{}
+}
+
+func (p *TimeEntryPatch) operation(op, field string, value any) *TimeEntryPatch {
+	p.operations = append(p.operations, &PatchOperation{Op: op, Path: "/" + field, Value: value})
+	return p
+}
+
+// Billable sets whether time entries are billable.
+func (p *TimeEntryPatch) Billable(billable bool) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "billable", billable)
+}
+
+// Description sets the description of time entries.
+func (p *TimeEntryPatch) Description(description string) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "description", description)
+}
+
+// ProjectID sets the project of time entries.
+func (p *TimeEntryPatch) ProjectID(projectID int) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "project_id", projectID)
+}
+
+// TaskID sets the task of time entries.
+func (p *TimeEntryPatch) TaskID(taskID int) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "task_id", taskID)
+}
+
+// Start sets the start of time entries.
+func (p *TimeEntryPatch) Start(start time.Time) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "start", start)
+}
+
+// Stop sets the stop of time entries.
+func (p *TimeEntryPatch) Stop(stop time.Time) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "stop", stop)
+}
+
+// Tags replaces the tags of time entries.
+func (p *TimeEntryPatch) Tags(tags []string) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "tags", tags)
+}
+
+// AddTags adds the tags to time entries.
+func (p *TimeEntryPatch) AddTags(tags []string) *TimeEntryPatch {
+	return p.operation(PatchOpAdd, "tags", tags)
+}
+
+// RemoveTags removes the tags from time entries.
+func (p *TimeEntryPatch) RemoveTags(tags []string) *TimeEntryPatch {
+	return p.operation(PatchOpRemove, "tags", tags)
+}
+
+// TagIDs replaces the tags of time entries by their IDs.
+func (p *TimeEntryPatch) TagIDs(tagIDs []int) *TimeEntryPatch {
+	return p.operation(PatchOpReplace, "tag_ids", tagIDs)
+}
+
+// AddTagIDs adds the tags to time entries by their IDs.
+func (p *TimeEntryPatch) AddTagIDs(tagIDs []int) *TimeEntryPatch {
+	return p.operation(PatchOpAdd, "tag_ids", tagIDs)
+}
+
+// RemoveTagIDs removes the tags from time entries by their IDs.
+func (p *TimeEntryPatch) RemoveTagIDs(tagIDs []int) *TimeEntryPatch {
+	return p.operation(PatchOpRemove, "tag_ids", tagIDs)
+}
+
+// Operations returns the operations built so far.
+func (p *TimeEntryPatch) Operations() []*PatchOperation {
+	return p.operations
+}
+
+// PatchTimeEntries applies the operations to workspace time entries.
+// Since the number of time entries in a request is limited, timeEntryIDs are split into several requests if necessary,
+// and their results are merged. If a request fails, the results of the preceding requests are returned with the error.
+func (c *APIClient) PatchTimeEntries(ctx context.Context, workspaceID int, timeEntryIDs []int, operations []*PatchOperation) (*PatchResult, error) {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "time_entries")
+	patchResult, err := c.patchInChunks(ctx, apiSpecificPath, timeEntryIDs, maxPatchTimeEntryIDs, operations)
+	if err != nil {
+		return patchResult, errors.Wrap(err, "failed to patch time entries")
+	}
+	return patchResult, nil
+}
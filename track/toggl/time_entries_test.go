@@ -2,11 +2,16 @@ package toggl
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -98,14 +103,10 @@ func TestGetTimeEntries(t *testing.T) {
 				err         error
 			}{
 				timeEntries: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Since is expected to be an unix timestamp, integer value\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"59"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Since is expected to be an unix timestamp, integer value",
+					Body:       "\"Since is expected to be an unix timestamp, integer value\"\n",
 				},
 			},
 		},
@@ -123,13 +124,10 @@ func TestGetTimeEntries(t *testing.T) {
 				err         error
 			}{
 				timeEntries: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -147,13 +145,10 @@ func TestGetTimeEntries(t *testing.T) {
 				err         error
 			}{
 				timeEntries: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -171,10 +166,10 @@ func TestGetTimeEntries(t *testing.T) {
 				internal.Errorf(t, timeEntries, tt.out.timeEntries)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -310,13 +305,10 @@ func TestGetCurrentTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -334,13 +326,10 @@ func TestGetCurrentTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -358,10 +347,10 @@ func TestGetCurrentTimeEntry(t *testing.T) {
 				internal.Errorf(t, timeEntry, tt.out.timeEntry)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -434,14 +423,10 @@ func TestCreateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -459,13 +444,10 @@ func TestCreateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -483,13 +465,10 @@ func TestCreateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -502,16 +481,16 @@ func TestCreateTimeEntry(t *testing.T) {
 			defer mockServer.Close()
 
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
-			timeEntry, err := apiClient.CreateTimeEntry(context.Background(), workspaceID, &CreateTimeEntryRequestBody{})
+			timeEntry, err := apiClient.CreateTimeEntry(context.Background(), workspaceID, &CreateTimeEntryRequestBody{WorkspaceID: track.Ptr(workspaceID), CreatedWith: track.Ptr("toggl-go"), Start: track.Ptr(time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC))})
 
 			if !reflect.DeepEqual(timeEntry, tt.out.timeEntry) {
 				internal.Errorf(t, timeEntry, tt.out.timeEntry)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -591,6 +570,42 @@ func TestCreateTimeEntryRequestBody(t *testing.T) {
 	}
 }
 
+func TestStartTimeEntryRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateTimeEntryRequestBody
+		out  string
+	}{
+		{
+			name: "defaults are set",
+			in: &CreateTimeEntryRequestBody{
+				Description: track.Ptr("running time entry"),
+				Start:       track.Ptr(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.UTC)),
+			},
+			out: "{\"created_with\":\"toggl-go\",\"description\":\"running time entry\",\"duration\":-1,\"start\":\"2020-01-23T04:56:31Z\",\"workspace_id\":1234567}",
+		},
+		{
+			name: "created_with is kept and duration and stop are ignored",
+			in: &CreateTimeEntryRequestBody{
+				CreatedWith: track.Ptr("MyApp"),
+				Duration:    track.Ptr(3600),
+				Start:       track.Ptr(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.UTC)),
+				Stop:        track.Ptr(time.Date(2020, time.January, 23, 5, 56, 31, 0, time.UTC)),
+			},
+			out: "{\"created_with\":\"MyApp\",\"duration\":-1,\"start\":\"2020-01-23T04:56:31Z\",\"workspace_id\":1234567}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			_, _ = apiClient.StartTimeEntry(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
+
 func TestUpdateTimeEntry(t *testing.T) {
 	tests := []struct {
 		name string
@@ -653,14 +668,10 @@ func TestUpdateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -678,13 +689,10 @@ func TestUpdateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -702,13 +710,10 @@ func TestUpdateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -726,14 +731,10 @@ func TestUpdateTimeEntry(t *testing.T) {
 				err       error
 			}{
 				timeEntry: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Time entry not found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"23"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Time entry not found",
+					Body:       "\"Time entry not found\"\n",
 				},
 			},
 		},
@@ -753,10 +754,10 @@ func TestUpdateTimeEntry(t *testing.T) {
 				internal.Errorf(t, timeEntry, tt.out.timeEntry)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -781,7 +782,7 @@ func TestUpdateTimeEntryRequestBody(t *testing.T) {
 				Duration:    track.Ptr(300),
 				CreatedWith: track.Ptr("toggl-go"),
 				Description: track.Ptr("updated time entry"),
-				ProjectID:   track.Ptr(123456789),
+				ProjectID:   track.NewNullable(123456789),
 			},
 			out: "{\"created_with\":\"toggl-go\",\"description\":\"updated time entry\",\"duration\":300,\"project_id\":123456789,\"start\":\"2022-07-06T05:04:03Z\",\"workspace_id\":1234567}",
 		},
@@ -793,7 +794,7 @@ func TestUpdateTimeEntryRequestBody(t *testing.T) {
 				Duration:    track.Ptr(300),
 				CreatedWith: track.Ptr("toggl-go"),
 				Description: track.Ptr("updated time entry"),
-				ProjectID:   track.Ptr(123456789),
+				ProjectID:   track.NewNullable(123456789),
 				Billable:    track.Ptr(false),
 			},
 			out: "{\"billable\":false,\"created_with\":\"toggl-go\",\"description\":\"updated time entry\",\"duration\":300,\"project_id\":123456789,\"start\":\"2022-07-06T05:04:03Z\",\"workspace_id\":1234567}",
@@ -806,7 +807,7 @@ func TestUpdateTimeEntryRequestBody(t *testing.T) {
 				Duration:    track.Ptr(300),
 				CreatedWith: track.Ptr("toggl-go"),
 				Description: track.Ptr("updated time entry"),
-				ProjectID:   track.Ptr(123456789),
+				ProjectID:   track.NewNullable(123456789),
 				Tags:        []*string{track.Ptr("tag1"), track.Ptr("tag2")},
 			},
 			out: "{\"created_with\":\"toggl-go\",\"description\":\"updated time entry\",\"duration\":300,\"project_id\":123456789,\"start\":\"2022-07-06T05:04:03Z\",\"tags\":[\"tag1\",\"tag2\"],\"workspace_id\":1234567}",
@@ -819,10 +820,17 @@ func TestUpdateTimeEntryRequestBody(t *testing.T) {
 				Duration:    track.Ptr(300),
 				CreatedWith: track.Ptr("toggl-go"),
 				Description: track.Ptr("updated time entry"),
-				ProjectID:   track.Ptr(123456789),
+				ProjectID:   track.NewNullable(123456789),
 				TagIDs:      []*int{track.Ptr(1234567), track.Ptr(9876543)},
 			},
 			out: "{\"created_with\":\"toggl-go\",\"description\":\"updated time entry\",\"duration\":300,\"project_id\":123456789,\"start\":\"2022-07-06T05:04:03Z\",\"tag_ids\":[1234567,9876543],\"workspace_id\":1234567}",
+		}, {
+			name: "null",
+			in: &UpdateTimeEntryRequestBody{
+				ProjectID: track.Null[int](),
+				TaskID:    track.Null[int](),
+			},
+			out: "{\"project_id\":null,\"task_id\":null}",
 		},
 	}
 	for _, tt := range tests {
@@ -837,6 +845,221 @@ func TestUpdateTimeEntryRequestBody(t *testing.T) {
 	}
 }
 
+func TestPatchTimeEntries(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			patchResult *PatchResult
+			err         error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/time_entries/patch_time_entries_200_ok.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{
+					Success: []*int{track.Ptr(1234567890), track.Ptr(2345678901)},
+					Failure: []*PatchFailure{
+						{
+							ID:      track.Ptr(3456789012),
+							Message: track.Ptr("Time entry not found"),
+						},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/time_entries/patch_time_entries_400_bad_request.json",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid patch operation",
+					Body:       "\"Invalid patch operation\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/time_entries/patch_time_entries_401_unauthorized",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/time_entries/patch_time_entries_403_forbidden",
+			},
+			out: struct {
+				patchResult *PatchResult
+				err         error
+			}{
+				patchResult: &PatchResult{},
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			timeEntryIDs := []int{1234567890, 2345678901, 3456789012}
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "time_entries", "1234567890,2345678901,3456789012")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			patchResult, err := apiClient.PatchTimeEntries(context.Background(), workspaceID, timeEntryIDs, NewTimeEntryPatch().Billable(true).Operations())
+
+			if !reflect.DeepEqual(patchResult, tt.out.patchResult) {
+				internal.Errorf(t, patchResult, tt.out.patchResult)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchTimeEntriesRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *TimeEntryPatch
+		out  string
+	}{
+		{
+			name: "replace",
+			in:   NewTimeEntryPatch().Description("MyTimeEntry").ProjectID(123456789).Billable(false),
+			out:  "[{\"op\":\"replace\",\"path\":\"/description\",\"value\":\"MyTimeEntry\"},{\"op\":\"replace\",\"path\":\"/project_id\",\"value\":123456789},{\"op\":\"replace\",\"path\":\"/billable\",\"value\":false}]",
+		},
+		{
+			name: "add and remove",
+			in:   NewTimeEntryPatch().AddTags([]string{"toggl-go"}).RemoveTagIDs([]int{1234567}),
+			out:  "[{\"op\":\"add\",\"path\":\"/tags\",\"value\":[\"toggl-go\"]},{\"op\":\"remove\",\"path\":\"/tag_ids\",\"value\":[1234567]}]",
+		},
+		{
+			name: "time",
+			in:   NewTimeEntryPatch().Start(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.UTC)),
+			out:  "[{\"op\":\"replace\",\"path\":\"/start\",\"value\":\"2020-01-23T04:56:31Z\"}]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 1234567
+			timeEntryIDs := []int{1234567890, 2345678901}
+			_, _ = apiClient.PatchTimeEntries(context.Background(), workspaceID, timeEntryIDs, tt.in.Operations())
+		})
+	}
+}
+
+func TestPatchTimeEntriesChunked(t *testing.T) {
+	var mu sync.Mutex
+	var requestedIDs []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(path.Base(r.URL.Path), ",")
+		mu.Lock()
+		requestedIDs = append(requestedIDs, path.Base(r.URL.Path))
+		mu.Unlock()
+
+		// The last time entry in each request fails.
+		patchResult := &PatchResult{}
+		for i, id := range ids {
+			n, _ := strconv.Atoi(id)
+			if i == len(ids)-1 {
+				patchResult.Failure = append(patchResult.Failure, &PatchFailure{ID: track.Ptr(n), Message: track.Ptr("failed")})
+			} else {
+				patchResult.Success = append(patchResult.Success, track.Ptr(n))
+			}
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(patchResult)
+	}))
+	defer mockServer.Close()
+
+	timeEntryIDs := make([]int, 0, 250)
+	for i := 1; i <= 250; i++ {
+		timeEntryIDs = append(timeEntryIDs, i)
+	}
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	patchResult, err := apiClient.PatchTimeEntries(context.Background(), 1234567, timeEntryIDs, NewTimeEntryPatch().Billable(true).Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(requestedIDs) != 3 {
+		internal.Errorf(t, len(requestedIDs), 3)
+	}
+	if len(patchResult.Success) != 247 {
+		internal.Errorf(t, len(patchResult.Success), 247)
+	}
+	wantFailure := []*PatchFailure{
+		{ID: track.Ptr(100), Message: track.Ptr("failed")},
+		{ID: track.Ptr(200), Message: track.Ptr("failed")},
+		{ID: track.Ptr(250), Message: track.Ptr("failed")},
+	}
+	if !reflect.DeepEqual(patchResult.Failure, wantFailure) {
+		internal.Errorf(t, patchResult.Failure, wantFailure)
+	}
+}
+
 func TestDeleteTimeEntry(t *testing.T) {
 	tests := []struct {
 		name string
@@ -875,13 +1098,10 @@ func TestDeleteTimeEntry(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -897,13 +1117,10 @@ func TestDeleteTimeEntry(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -919,14 +1136,10 @@ func TestDeleteTimeEntry(t *testing.T) {
 			out: struct {
 				err error
 			}{
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 404,
-					Message:    "\"Time entry not found\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"23"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Time entry not found",
+					Body:       "\"Time entry not found\"\n",
 				},
 			},
 		},
@@ -942,10 +1155,10 @@ func TestDeleteTimeEntry(t *testing.T) {
 			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
 			err := apiClient.DeleteTimeEntry(context.Background(), workspaceID, timeEntryID)
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -955,3 +1168,454 @@ func TestDeleteTimeEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestStopTimeEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			timeEntry *TimeEntry
+			err       error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/time_entries/stop_time_entry_200_ok.json",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				err       error
+			}{
+				timeEntry: &TimeEntry{
+					ID:          track.Ptr(1234567890),
+					WorkspaceID: track.Ptr(1234567),
+					ProjectID:   track.Ptr(123456789),
+					Billable:    track.Ptr(false),
+					Start:       track.Ptr(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.Local)),
+					Stop:        track.Ptr(time.Date(2020, time.January, 23, 5, 56, 31, 0, time.Local)),
+					Duration:    track.Ptr(3600),
+					Description: track.Ptr("running time entry"),
+					Tags:        []*string{track.Ptr("toggl-go")},
+					TagIDs:      []*int{track.Ptr(1234567)},
+					Duronly:     track.Ptr(false),
+					At:          track.Ptr(time.Date(2020, time.January, 23, 5, 56, 32, 0, time.Local)),
+					UserID:      track.Ptr(1234567),
+					UID:         track.Ptr(1234567),
+					WID:         track.Ptr(1234567),
+					PID:         track.Ptr(123456789),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/time_entries/stop_time_entry_400_bad_request.json",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				err       error
+			}{
+				timeEntry: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Time entry is not running",
+					Body:       "\"Time entry is not running\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/time_entries/stop_time_entry_401_unauthorized",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				err       error
+			}{
+				timeEntry: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/time_entries/stop_time_entry_403_forbidden",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				err       error
+			}{
+				timeEntry: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/time_entries/stop_time_entry_404_not_found.json",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				err       error
+			}{
+				timeEntry: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 1234567
+			timeEntryID := 1234567890
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "time_entries", strconv.Itoa(timeEntryID), "stop")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			timeEntry, err := apiClient.StopTimeEntry(context.Background(), workspaceID, timeEntryID)
+
+			if !reflect.DeepEqual(timeEntry, tt.out.timeEntry) {
+				internal.Errorf(t, timeEntry, tt.out.timeEntry)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestStopCurrentTimeEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			currentTestdataFile string
+		}
+		out struct {
+			timeEntry *TimeEntry
+			stopped   bool
+			err       error
+		}
+	}{
+		{
+			name: "running time entry",
+			in: struct {
+				currentTestdataFile string
+			}{
+				currentTestdataFile: "testdata/time_entries/get_current_time_entry_200_ok.json",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				stopped   bool
+				err       error
+			}{
+				timeEntry: &TimeEntry{
+					ID:          track.Ptr(1234567890),
+					WorkspaceID: track.Ptr(1234567),
+					ProjectID:   track.Ptr(123456789),
+					Billable:    track.Ptr(false),
+					Start:       track.Ptr(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.Local)),
+					Stop:        track.Ptr(time.Date(2020, time.January, 23, 5, 56, 31, 0, time.Local)),
+					Duration:    track.Ptr(3600),
+					Description: track.Ptr("running time entry"),
+					Tags:        []*string{track.Ptr("toggl-go")},
+					TagIDs:      []*int{track.Ptr(1234567)},
+					Duronly:     track.Ptr(false),
+					At:          track.Ptr(time.Date(2020, time.January, 23, 5, 56, 32, 0, time.Local)),
+					UserID:      track.Ptr(1234567),
+					UID:         track.Ptr(1234567),
+					WID:         track.Ptr(1234567),
+					PID:         track.Ptr(123456789),
+				},
+				stopped: true,
+				err:     nil,
+			},
+		},
+		{
+			name: "no running time entry",
+			in: struct {
+				currentTestdataFile string
+			}{
+				currentTestdataFile: "testdata/time_entries/get_current_time_entry_200_ok_null.json",
+			},
+			out: struct {
+				timeEntry *TimeEntry
+				stopped   bool
+				err       error
+			}{
+				timeEntry: nil,
+				stopped:   false,
+				err:       nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, err := os.ReadFile(tt.in.currentTestdataFile)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			stopped, err := os.ReadFile("testdata/time_entries/stop_time_entry_200_ok.json")
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			stopCalled := false
+			mux := http.NewServeMux()
+			mux.HandleFunc(path.Join("/", mePath, "time_entries/current"), func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.Write(current)
+			})
+			mux.HandleFunc(path.Join("/", workspacesPath, "1234567/time_entries/1234567890/stop"), func(w http.ResponseWriter, r *http.Request) {
+				stopCalled = true
+				if r.Method != http.MethodPatch {
+					internal.Errorf(t, r.Method, http.MethodPatch)
+				}
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.Write(stopped)
+			})
+			mockServer := httptest.NewServer(mux)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			timeEntry, err := apiClient.StopCurrentTimeEntry(context.Background())
+
+			if !reflect.DeepEqual(timeEntry, tt.out.timeEntry) {
+				internal.Errorf(t, timeEntry, tt.out.timeEntry)
+			}
+			if stopCalled != tt.out.stopped {
+				internal.Errorf(t, stopCalled, tt.out.stopped)
+			}
+			if !reflect.DeepEqual(err, tt.out.err) {
+				internal.Errorf(t, err, tt.out.err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryElapsed(t *testing.T) {
+	now := time.Date(2020, time.January, 23, 5, 56, 31, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   *TimeEntry
+		out  struct {
+			isRunning bool
+			elapsed   time.Duration
+		}
+	}{
+		{
+			name: "stopped time entry",
+			in:   &TimeEntry{Duration: track.Ptr(3600)},
+			out: struct {
+				isRunning bool
+				elapsed   time.Duration
+			}{isRunning: false, elapsed: time.Hour},
+		},
+		{
+			name: "running time entry",
+			in: &TimeEntry{
+				Start:    track.Ptr(time.Date(2020, time.January, 23, 4, 56, 31, 0, time.UTC)),
+				Duration: track.Ptr(-1),
+			},
+			out: struct {
+				isRunning bool
+				elapsed   time.Duration
+			}{isRunning: true, elapsed: time.Hour},
+		},
+		{
+			name: "running time entry with the negative start in Unix time",
+			in:   &TimeEntry{Duration: track.Ptr(-1579755391)},
+			out: struct {
+				isRunning bool
+				elapsed   time.Duration
+			}{isRunning: true, elapsed: time.Hour},
+		},
+		{
+			name: "duration is nil",
+			in:   &TimeEntry{},
+			out: struct {
+				isRunning bool
+				elapsed   time.Duration
+			}{isRunning: false, elapsed: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if isRunning := tt.in.IsRunning(); isRunning != tt.out.isRunning {
+				internal.Errorf(t, isRunning, tt.out.isRunning)
+			}
+			if elapsed := tt.in.elapsed(now); elapsed != tt.out.elapsed {
+				internal.Errorf(t, elapsed, tt.out.elapsed)
+			}
+		})
+	}
+}
+
+func TestUpdateTimeEntryWithInvalidTagAction(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request with invalid tag action was sent")
+	}))
+	defer mockServer.Close()
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	reqBody := &UpdateTimeEntryRequestBody{TagAction: track.Ptr(TagAction("remove")), Tags: []*string{track.Ptr("tag")}}
+	_, err := apiClient.UpdateTimeEntry(context.Background(), 1234567, 123456789, reqBody)
+	if err == nil || !strings.Contains(err.Error(), "tag_action: \"remove\" is not a valid value") {
+		internal.Errorf(t, err, "tag_action: \"remove\" is not a valid value")
+	}
+}
+
+func TestCreateTimeEntryRequestBodyValidate(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   *CreateTimeEntryRequestBody
+		out  []*track.FieldError
+	}{
+		{
+			name: "valid",
+			in:   &CreateTimeEntryRequestBody{WorkspaceID: track.Ptr(1234567), CreatedWith: track.Ptr("toggl-go"), Start: &start, Stop: track.Ptr(start.Add(time.Hour))},
+			out:  nil,
+		},
+		{
+			name: "missing workspace_id, created_with, and start",
+			in:   &CreateTimeEntryRequestBody{Description: track.Ptr("description")},
+			out: []*track.FieldError{
+				{Field: "workspace_id", Message: "is required"},
+				{Field: "created_with", Message: "is required"},
+				{Field: "start", Message: "is required"},
+			},
+		},
+		{
+			name: "stop before start",
+			in:   &CreateTimeEntryRequestBody{WorkspaceID: track.Ptr(1234567), CreatedWith: track.Ptr("toggl-go"), Start: &start, Stop: track.Ptr(start.Add(-time.Hour))},
+			out: []*track.FieldError{
+				{Field: "stop", Message: "must not be before start"},
+			},
+		},
+		{
+			name: "start_date not in YYYY-MM-DD",
+			in:   &CreateTimeEntryRequestBody{WorkspaceID: track.Ptr(1234567), CreatedWith: track.Ptr("toggl-go"), Start: &start, StartDate: track.Ptr("01/01/2023")},
+			out: []*track.FieldError{
+				{Field: "start_date", Message: "must be in the format of YYYY-MM-DD"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			var validationError *track.ValidationError
+			if tt.out == nil {
+				if err != nil {
+					internal.Errorf(t, err, nil)
+				}
+				return
+			}
+			if !errors.As(err, &validationError) {
+				t.Fatalf("want *track.ValidationError, got %v", err)
+			}
+			if !reflect.DeepEqual(validationError.Fields, tt.out) {
+				internal.Errorf(t, validationError.Fields, tt.out)
+			}
+		})
+	}
+}
+
+func TestGetTimeEntriesQueryValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *GetTimeEntriesQuery
+		out  string
+	}{
+		{
+			name: "dates",
+			in:   &GetTimeEntriesQuery{StartDate: track.Ptr("2023-01-01"), EndDate: track.Ptr("2023-01-31")},
+			out:  "",
+		},
+		{
+			name: "RFC 3339",
+			in:   &GetTimeEntriesQuery{StartDate: track.Ptr("2023-01-01T00:00:00Z"), EndDate: track.Ptr("2023-01-31T00:00:00Z")},
+			out:  "",
+		},
+		{
+			name: "end_date without start_date",
+			in:   &GetTimeEntriesQuery{EndDate: track.Ptr("2023-01-31")},
+			out:  "invalid request: end_date: must be given together with start_date",
+		},
+		{
+			name: "end_date before start_date",
+			in:   &GetTimeEntriesQuery{StartDate: track.Ptr("2023-01-31"), EndDate: track.Ptr("2023-01-01")},
+			out:  "invalid request: end_date: must not be before start_date",
+		},
+		{
+			name: "invalid before",
+			in:   &GetTimeEntriesQuery{Before: track.Ptr("yesterday")},
+			out:  "invalid request: before: must be in the format of YYYY-MM-DD or RFC 3339",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			if (err == nil && tt.out != "") || (err != nil && err.Error() != tt.out) {
+				internal.Errorf(t, err, tt.out)
+			}
+		})
+	}
+}
+
+func TestCreateTimeEntryWithoutValidation(t *testing.T) {
+	mockServer := internal.NewMockServerToAssertRequestBody(t, "{}")
+	defer mockServer.Close()
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	_, err := apiClient.CreateTimeEntry(context.Background(), 1234567, &CreateTimeEntryRequestBody{})
+	if !track.IsValidationError(err) {
+		internal.Errorf(t, err, &track.ValidationError{})
+	}
+
+	apiClient = NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL), WithoutValidation())
+	_, _ = apiClient.CreateTimeEntry(context.Background(), 1234567, &CreateTimeEntryRequestBody{})
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
@@ -20,12 +21,22 @@ const (
 	mePath            string = "api/v9/me"
 	organizationsPath string = "api/v9/organizations"
 	workspacesPath    string = "api/v9/workspaces"
+
+	createdWith string = "toggl-go" // The name of this library, which is sent as created_with.
+
+	// iteratorPerPage is the page size which the paginating iterators request if the query doesn't specify one,
+	// so that a page shorter than it is known to be the last one.
+	iteratorPerPage int = 50
 )
 
 // APIClient is a client for interacting with Toggl API v9.
+// It is safe for concurrent use by multiple goroutines.
 type APIClient struct {
-	baseURL    *url.URL
-	httpClient *http.Client
+	baseURL        *url.URL
+	httpClient     *http.Client
+	retryPolicy    *track.RetryPolicy
+	rateLimiter    *track.RateLimiter
+	skipValidation bool
 
 	apiToken string
 }
@@ -74,6 +85,46 @@ func (a apiTokenOption) apply(c *APIClient) {
 	c.apiToken = string(a)
 }
 
+// WithRetryPolicy returns a Option that specifies the policy to retry requests which failed temporarily.
+// Requests are not retried by default.
+func WithRetryPolicy(retryPolicy *track.RetryPolicy) Option {
+	return &retryPolicyOption{retryPolicy: retryPolicy}
+}
+
+type retryPolicyOption struct {
+	retryPolicy *track.RetryPolicy
+}
+
+func (r *retryPolicyOption) apply(c *APIClient) {
+	c.retryPolicy = r.retryPolicy
+}
+
+// WithRateLimiter returns a Option that specifies the rate limiter which every request waits for.
+// The same rate limiter can be shared by clients of toggl, reports, and webhooks using the same API token.
+func WithRateLimiter(rateLimiter *track.RateLimiter) Option {
+	return &rateLimiterOption{rateLimiter: rateLimiter}
+}
+
+type rateLimiterOption struct {
+	rateLimiter *track.RateLimiter
+}
+
+func (r *rateLimiterOption) apply(c *APIClient) {
+	c.rateLimiter = r.rateLimiter
+}
+
+// WithoutValidation returns a Option that disables the validation of request bodies and queries before they're sent.
+// Requests are validated by default so that invalid ones fail fast with a *track.ValidationError.
+func WithoutValidation() Option {
+	return withoutValidationOption{}
+}
+
+type withoutValidationOption struct{}
+
+func (withoutValidationOption) apply(c *APIClient) {
+	c.skipValidation = true
+}
+
 // withBaseURL makes client testable by configurable URL.
 func withBaseURL(baseURL string) Option {
 	return baseURLOption(baseURL)
@@ -110,6 +161,14 @@ func (c *APIClient) httpPut(ctx context.Context, apiSpecificPath string, reqBody
 	return c.do(req, respBody)
 }
 
+func (c *APIClient) httpPatch(ctx context.Context, apiSpecificPath string, reqBody, respBody any) error {
+	req, err := c.newRequest(ctx, http.MethodPatch, apiSpecificPath, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a new PATCH request")
+	}
+	return c.do(req, respBody)
+}
+
 func (c *APIClient) httpDelete(ctx context.Context, apiSpecificPath string) error {
 	req, err := c.newRequest(ctx, http.MethodDelete, apiSpecificPath, nil)
 	if err != nil {
@@ -119,10 +178,17 @@ func (c *APIClient) httpDelete(ctx context.Context, apiSpecificPath string) erro
 }
 
 func (c *APIClient) newRequest(ctx context.Context, httpMethod, apiSpecificPath string, input any) (*http.Request, error) {
-	url := c.baseURL
+	if !c.skipValidation {
+		if err := internal.Validate(input); err != nil {
+			return nil, errors.Wrap(err, "failed to validate a request")
+		}
+	}
+
+	// Copy baseURL so that concurrent requests don't share the URL.
+	url := *c.baseURL
 	url.Path = path.Join(url.Path, apiSpecificPath)
 
-	req, err := internal.NewRequest(ctx, httpMethod, url, input)
+	req, err := internal.NewRequest(ctx, httpMethod, &url, input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create a new request")
 	}
@@ -133,5 +199,6 @@ func (c *APIClient) newRequest(ctx context.Context, httpMethod, apiSpecificPath
 }
 
 func (c *APIClient) do(req *http.Request, respBody any) error {
-	return internal.Do(c.httpClient, req, respBody)
+	_, err := internal.Do(c.httpClient, req, respBody, c.retryPolicy, c.rateLimiter)
+	return err
 }
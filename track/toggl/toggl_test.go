@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
@@ -48,3 +49,31 @@ func TestNewAPIClientWithAPIToken(t *testing.T) {
 		internal.Errorf(t, apiClient.apiToken, internal.APIToken)
 	}
 }
+
+func TestNewAPIClientWithRetryPolicy(t *testing.T) {
+	retryPolicy := track.DefaultRetryPolicy()
+
+	apiClient := NewAPIClient(WithRetryPolicy(retryPolicy))
+
+	if !reflect.DeepEqual(apiClient.retryPolicy, retryPolicy) {
+		internal.Errorf(t, apiClient.retryPolicy, retryPolicy)
+	}
+}
+
+func TestNewAPIClientWithRateLimiter(t *testing.T) {
+	rateLimiter := track.NewRateLimiter(time.Second, 1)
+
+	apiClient := NewAPIClient(WithRateLimiter(rateLimiter))
+
+	if apiClient.rateLimiter != rateLimiter {
+		internal.Errorf(t, apiClient.rateLimiter, rateLimiter)
+	}
+}
+
+func TestNewAPIClientWithoutValidation(t *testing.T) {
+	apiClient := NewAPIClient(WithoutValidation())
+
+	if !apiClient.skipValidation {
+		internal.Errorf(t, apiClient.skipValidation, true)
+	}
+}
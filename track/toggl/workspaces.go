@@ -2,11 +2,14 @@ package toggl
 
 import (
 	"context"
+	"io"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // Workspace represents the properties of a workspace.
@@ -91,6 +94,41 @@ func (c *APIClient) GetWorkspace(ctx context.Context, workspaceID int) (*Workspa
 	return workspace, nil
 }
 
+// CreateWorkspaceRequestBody represents a request body of CreateWorkspace.
+type CreateWorkspaceRequestBody struct {
+	Admins                      []*int          `json:"admins,omitempty"`
+	DefaultCurrency             *string         `json:"default_currency,omitempty"`
+	DefaultHourlyRate           *int            `json:"default_hourly_rate,omitempty"`
+	InitialPricingPlan          *int            `json:"initial_pricing_plan,omitempty"`
+	Name                        *string         `json:"name,omitempty"`
+	OnlyAdminsMayCreateProjects *bool           `json:"only_admins_may_create_projects,omitempty"`
+	OnlyAdminsMayCreateTags     *bool           `json:"only_admins_may_create_tags,omitempty"`
+	OnlyAdminsSeeBillableRates  *bool           `json:"only_admins_see_billable_rates,omitempty"`
+	OnlyAdminsSeeTeamDashboard  *bool           `json:"only_admins_see_team_dashboard,omitempty"`
+	ProjectsBillableByDefault   *bool           `json:"projects_billable_by_default,omitempty"`
+	RateChangeMode              *RateChangeMode `json:"rate_change_mode,omitempty"`
+	ReportsCollapse             *bool           `json:"reports_collapse,omitempty"`
+	Rounding                    *int            `json:"rounding,omitempty"`
+	RoundingMinutes             *int            `json:"rounding_minutes,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateWorkspaceRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Name, "name")
+	return v.Err()
+}
+
+// CreateWorkspace creates a workspace within an organization.
+func (c *APIClient) CreateWorkspace(ctx context.Context, organizationID int, reqBody *CreateWorkspaceRequestBody) (*Workspace, error) {
+	var workspace *Workspace
+	apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "workspaces")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &workspace); err != nil {
+		return nil, errors.Wrap(err, "failed to create workspace")
+	}
+	return workspace, nil
+}
+
 // WorkspaceUser represents the properties of a user who belong to a workspace.
 type WorkspaceUser struct {
 	ID                *int       `json:"id,omitempty"`
@@ -111,13 +149,10 @@ type WorkspaceUser struct {
 	InviteURL         *string    `json:"invite_url,omitempty"`
 	InvitationCode    *string    `json:"invitation_code,omitempty"`
 	AvatarFileName    *string    `json:"avatar_file_name,omitempty"`
-	GroupIDs          *groupIDs  `json:"group_ids,omitempty"`
+	GroupIDs          []int      `json:"group_ids,omitempty"`
 	IsDirect          *bool      `json:"is_direct,omitempty"`
 }
 
-type groupIDs struct {
-}
-
 // GetWorkspaceUsers returns any users who belong to the workspace directly or through at least one group.
 func (c *APIClient) GetWorkspaceUsers(ctx context.Context, organizationID, workspaceID int) ([]*WorkspaceUser, error) {
 	var workspaceUsers []*WorkspaceUser
@@ -130,21 +165,28 @@ func (c *APIClient) GetWorkspaceUsers(ctx context.Context, organizationID, works
 
 // UpdateWorkspaceRequestBody represents a request body of UpdateWorkspace.
 type UpdateWorkspaceRequestBody struct {
-	Admins                      []*int  `json:"admins,omitempty"`
-	DefaultCurrency             *string `json:"default_currency,omitempty"`
-	DefaultHourlyRate           *int    `json:"default_hourly_rate,omitempty"`
-	InitialPricingPlan          *int    `json:"initial_pricing_plan,omitempty"`
-	Name                        *string `json:"name,omitempty"`
-	OnlyAdminsMayCreateProjects *bool   `json:"only_admins_may_create_projects,omitempty"`
-	OnlyAdminsMayCreateTags     *bool   `json:"only_admins_may_create_tags,omitempty"`
-	OnlyAdminsSeeBillableRates  *bool   `json:"only_admins_see_billable_rates,omitempty"`
-	OnlyAdminsSeeTeamDashboard  *bool   `json:"only_admins_see_team_dashboard,omitempty"`
-	OrganizationID              *int    `json:"organization_id,omitempty"`
-	ProjectsBillableByDefault   *bool   `json:"projects_billable_by_default,omitempty"`
-	RateChangeMode              *string `json:"rate_change_mode,omitempty"`
-	ReportsCollapse             *bool   `json:"reports_collapse,omitempty"`
-	Rounding                    *int    `json:"rounding,omitempty"`
-	RoundingMinutes             *int    `json:"rounding_minutes,omitempty"`
+	Admins                      []*int              `json:"admins,omitempty"`
+	DefaultCurrency             *string             `json:"default_currency,omitempty"`
+	DefaultHourlyRate           track.Nullable[int] `json:"default_hourly_rate,omitempty"`
+	InitialPricingPlan          *int                `json:"initial_pricing_plan,omitempty"`
+	Name                        *string             `json:"name,omitempty"`
+	OnlyAdminsMayCreateProjects *bool               `json:"only_admins_may_create_projects,omitempty"`
+	OnlyAdminsMayCreateTags     *bool               `json:"only_admins_may_create_tags,omitempty"`
+	OnlyAdminsSeeBillableRates  *bool               `json:"only_admins_see_billable_rates,omitempty"`
+	OnlyAdminsSeeTeamDashboard  *bool               `json:"only_admins_see_team_dashboard,omitempty"`
+	OrganizationID              *int                `json:"organization_id,omitempty"`
+	ProjectsBillableByDefault   *bool               `json:"projects_billable_by_default,omitempty"`
+	RateChangeMode              *RateChangeMode     `json:"rate_change_mode,omitempty"`
+	ReportsCollapse             *bool               `json:"reports_collapse,omitempty"`
+	Rounding                    *int                `json:"rounding,omitempty"`
+	RoundingMinutes             *int                `json:"rounding_minutes,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateWorkspaceRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.NotEmpty(r.Name, "name")
+	return v.Err()
 }
 
 // UpdateWorkspace updates a specific workspace.
@@ -156,3 +198,112 @@ func (c *APIClient) UpdateWorkspace(ctx context.Context, workspaceID int, reqBod
 	}
 	return workspace, nil
 }
+
+// UpdateWorkspaceUserRequestBody represents a request body of UpdateWorkspaceUser.
+type UpdateWorkspaceUserRequestBody struct {
+	Admin                *bool               `json:"admin,omitempty"`
+	Rate                 track.Nullable[int] `json:"rate,omitempty"`
+	RateChangeMode       *RateChangeMode     `json:"rate_change_mode,omitempty"`
+	LabourCost           track.Nullable[int] `json:"labour_cost,omitempty"`
+	LabourCostChangeMode *RateChangeMode     `json:"labour_cost_change_mode,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateWorkspaceUserRequestBody) Validate() error {
+	return internal.NewValidator(r).Err()
+}
+
+// UpdateWorkspaceUser updates the admin role, the rate, and the labour cost of a user in a workspace.
+func (c *APIClient) UpdateWorkspaceUser(ctx context.Context, workspaceID, workspaceUserID int, reqBody *UpdateWorkspaceUserRequestBody) (*WorkspaceUser, error) {
+	var workspaceUser *WorkspaceUser
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "workspace_users", strconv.Itoa(workspaceUserID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &workspaceUser); err != nil {
+		return nil, errors.Wrap(err, "failed to update workspace user")
+	}
+	return workspaceUser, nil
+}
+
+// DeleteWorkspaceUser removes a user from a workspace.
+func (c *APIClient) DeleteWorkspaceUser(ctx context.Context, workspaceID, workspaceUserID int) error {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "workspace_users", strconv.Itoa(workspaceUserID))
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete workspace user")
+	}
+	return nil
+}
+
+// WorkspaceLogo represents the logo of a workspace.
+type WorkspaceLogo struct {
+	Logo *string `json:"logo,omitempty"`
+}
+
+// GetWorkspaceLogo returns the URL of the logo of a workspace.
+func (c *APIClient) GetWorkspaceLogo(ctx context.Context, workspaceID int) (*WorkspaceLogo, error) {
+	var workspaceLogo *WorkspaceLogo
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+	if err := c.httpGet(ctx, apiSpecificPath, nil, &workspaceLogo); err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace logo")
+	}
+	return workspaceLogo, nil
+}
+
+// UploadWorkspaceLogo uploads the image read from logo as the logo of a workspace.
+// The fileName is sent as the name of the uploaded file, and its extension should match the image format.
+func (c *APIClient) UploadWorkspaceLogo(ctx context.Context, workspaceID int, fileName string, logo io.Reader) (*WorkspaceLogo, error) {
+	var workspaceLogo *WorkspaceLogo
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+	file := &internal.File{FieldName: "file", FileName: fileName, Content: logo}
+	if err := c.httpPost(ctx, apiSpecificPath, file, &workspaceLogo); err != nil {
+		return nil, errors.Wrap(err, "failed to upload workspace logo")
+	}
+	return workspaceLogo, nil
+}
+
+// DeleteWorkspaceLogo deletes the logo of a workspace.
+func (c *APIClient) DeleteWorkspaceLogo(ctx context.Context, workspaceID int) error {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete workspace logo")
+	}
+	return nil
+}
+
+// WorkspacePreferences represents the preferences of a workspace.
+type WorkspacePreferences struct {
+	HideStartEndTimes *bool   `json:"hide_start_end_times,omitempty"`
+	ReportLockedAt    *string `json:"report_locked_at,omitempty"`
+}
+
+// GetWorkspacePreferences returns the preferences of a workspace.
+func (c *APIClient) GetWorkspacePreferences(ctx context.Context, workspaceID int) (*WorkspacePreferences, error) {
+	var workspacePreferences *WorkspacePreferences
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "preferences")
+	if err := c.httpGet(ctx, apiSpecificPath, nil, &workspacePreferences); err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace preferences")
+	}
+	return workspacePreferences, nil
+}
+
+// UpdateWorkspacePreferencesRequestBody represents a request body of UpdateWorkspacePreferences.
+type UpdateWorkspacePreferencesRequestBody struct {
+	HideStartEndTimes *bool                  `json:"hide_start_end_times,omitempty"`
+	ReportLockedAt    track.Nullable[string] `json:"report_locked_at,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateWorkspacePreferencesRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	if reportLockedAt, ok := r.ReportLockedAt.Get(); ok {
+		v.Date(&reportLockedAt, "report_locked_at")
+	}
+	return v.Err()
+}
+
+// UpdateWorkspacePreferences updates the preferences of a workspace.
+func (c *APIClient) UpdateWorkspacePreferences(ctx context.Context, workspaceID int, reqBody *UpdateWorkspacePreferencesRequestBody) error {
+	apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "preferences")
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, nil); err != nil {
+		return errors.Wrap(err, "failed to update workspace preferences")
+	}
+	return nil
+}
@@ -3,10 +3,13 @@ package toggl
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"path"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -85,14 +88,10 @@ func TestGetWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid workspace_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid workspace_id",
+					Body:       "\"Missing or invalid workspace_id\"\n",
 				},
 			},
 		},
@@ -110,13 +109,10 @@ func TestGetWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -134,13 +130,10 @@ func TestGetWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -159,10 +152,10 @@ func TestGetWorkspace(t *testing.T) {
 				internal.Errorf(t, workspace, tt.out.workspace)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -218,7 +211,7 @@ func TestGetWorkspaceUsers(t *testing.T) {
 						InviteURL:         nil,
 						InvitationCode:    nil,
 						AvatarFileName:    nil,
-						GroupIDs:          nil,
+						GroupIDs:          []int{4567890, 5678901},
 						IsDirect:          track.Ptr(true),
 					},
 				},
@@ -239,14 +232,10 @@ func TestGetWorkspaceUsers(t *testing.T) {
 				err            error
 			}{
 				workspaceUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"Missing or invalid workspace_id\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Missing or invalid workspace_id",
+					Body:       "\"Missing or invalid workspace_id\"\n",
 				},
 			},
 		},
@@ -264,13 +253,10 @@ func TestGetWorkspaceUsers(t *testing.T) {
 				err            error
 			}{
 				workspaceUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -288,13 +274,10 @@ func TestGetWorkspaceUsers(t *testing.T) {
 				err            error
 			}{
 				workspaceUsers: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Forbidden",
+					Body:       "",
 				},
 			},
 		},
@@ -314,10 +297,10 @@ func TestGetWorkspaceUsers(t *testing.T) {
 				internal.Errorf(t, workspaceUsers, tt.out.workspaceUsers)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -399,14 +382,10 @@ func TestUpdateWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 400,
-					Message:    "\"JSON is not valid\"\n",
-					Header: http.Header{
-						"Content-Length": []string{"20"},
-						"Content-Type":   []string{"application/json; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
 				},
 			},
 		},
@@ -424,13 +403,10 @@ func TestUpdateWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 401,
-					Message:    "",
-					Header: http.Header{
-						"Content-Length": []string{"0"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Message:    "Unauthorized",
+					Body:       "",
 				},
 			},
 		},
@@ -448,14 +424,10 @@ func TestUpdateWorkspace(t *testing.T) {
 				err       error
 			}{
 				workspace: nil,
-				err: &internal.ErrorResponse{
+				err: &track.APIError{
 					StatusCode: 403,
 					Message:    "Incorrect username and/or password",
-					Header: http.Header{
-						"Content-Length": []string{"34"},
-						"Content-Type":   []string{"text/plain; charset=utf-8"},
-						"Date":           []string{time.Now().In(time.FixedZone("GMT", 0)).Format(time.RFC1123)},
-					},
+					Body:       "Incorrect username and/or password",
 				},
 			},
 		},
@@ -474,10 +446,10 @@ func TestUpdateWorkspace(t *testing.T) {
 				internal.Errorf(t, workspace, tt.out.workspace)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -524,3 +496,1138 @@ func TestUpdateWorkspaceRequestBody(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateWorkspaceUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			workspaceUser *WorkspaceUser
+			err           error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/update_workspace_user_200_ok.json",
+			},
+			out: struct {
+				workspaceUser *WorkspaceUser
+				err           error
+			}{
+				workspaceUser: &WorkspaceUser{
+					ID:                track.Ptr(1234567),
+					UserID:            track.Ptr(2345678),
+					WorkspaceID:       track.Ptr(3456789),
+					Admin:             track.Ptr(true),
+					OrganizationAdmin: track.Ptr(false),
+					WorkspaceAdmin:    track.Ptr(true),
+					Active:            track.Ptr(true),
+					Email:             track.Ptr("example@toggl.com"),
+					Timezone:          track.Ptr("Asia/Tokyo"),
+					Inactive:          track.Ptr(false),
+					At:                track.Ptr(time.Date(2020, time.January, 23, 4, 56, 7, 0, time.Local)),
+					Name:              track.Ptr("Toggl Track"),
+					Rate:              track.Ptr(100),
+					RateLastUpdated:   track.Ptr("2020-01-23T04:56:07.000Z"),
+					LabourCost:        track.Ptr(50),
+					IsDirect:          track.Ptr(true),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/workspaces/update_workspace_user_400_bad_request.json",
+			},
+			out: struct {
+				workspaceUser *WorkspaceUser
+				err           error
+			}{
+				workspaceUser: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/update_workspace_user_401_unauthorized",
+			},
+			out: struct {
+				workspaceUser *WorkspaceUser
+				err           error
+			}{
+				workspaceUser: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/update_workspace_user_403_forbidden",
+			},
+			out: struct {
+				workspaceUser *WorkspaceUser
+				err           error
+			}{
+				workspaceUser: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/workspaces/update_workspace_user_404_not_found.json",
+			},
+			out: struct {
+				workspaceUser *WorkspaceUser
+				err           error
+			}{
+				workspaceUser: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 3456789
+			workspaceUserID := 1234567
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "workspace_users", strconv.Itoa(workspaceUserID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceUser, err := apiClient.UpdateWorkspaceUser(context.Background(), workspaceID, workspaceUserID, &UpdateWorkspaceUserRequestBody{})
+
+			if !reflect.DeepEqual(workspaceUser, tt.out.workspaceUser) {
+				internal.Errorf(t, workspaceUser, tt.out.workspaceUser)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateWorkspaceUserRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateWorkspaceUserRequestBody
+		out  string
+	}{
+		{
+			name: "bool",
+			in: &UpdateWorkspaceUserRequestBody{
+				Admin: track.Ptr(true),
+			},
+			out: "{\"admin\":true}",
+		},
+		{
+			name: "int and string",
+			in: &UpdateWorkspaceUserRequestBody{
+				Rate:                 track.NewNullable(100),
+				RateChangeMode:       track.Ptr(RateChangeModeStartToday),
+				LabourCost:           track.NewNullable(50),
+				LabourCostChangeMode: track.Ptr(RateChangeModeOverrideAll),
+			},
+			out: "{\"rate\":100,\"rate_change_mode\":\"start-today\",\"labour_cost\":50,\"labour_cost_change_mode\":\"override-all\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 3456789
+			workspaceUserID := 1234567
+			_, _ = apiClient.UpdateWorkspaceUser(context.Background(), workspaceID, workspaceUserID, tt.in)
+		})
+	}
+}
+
+func TestDeleteWorkspaceUser(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/delete_workspace_user_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/workspaces/delete_workspace_user_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "We're expecting an integer as part of the url for workspace_user_id",
+					Body:       "\"We're expecting an integer as part of the url for workspace_user_id\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/delete_workspace_user_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/delete_workspace_user_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/workspaces/delete_workspace_user_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 3456789
+			workspaceUserID := 1234567
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "workspace_users", strconv.Itoa(workspaceUserID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.DeleteWorkspaceUser(context.Background(), workspaceID, workspaceUserID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			workspace *Workspace
+			err       error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/create_workspace_200_ok.json",
+			},
+			out: struct {
+				workspace *Workspace
+				err       error
+			}{
+				workspace: &Workspace{
+					ID:                          track.Ptr(2345678),
+					OrganizationID:              track.Ptr(1234567),
+					Name:                        track.Ptr("Sandbox Workspace"),
+					Premium:                     track.Ptr(false),
+					Admin:                       track.Ptr(true),
+					DefaultHourlyRate:           track.Ptr(100),
+					DefaultCurrency:             track.Ptr("USD"),
+					OnlyAdminsMayCreateProjects: track.Ptr(true),
+					Rounding:                    track.Ptr(1),
+					RoundingMinutes:             track.Ptr(15),
+					At:                          track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.Local)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/workspaces/create_workspace_400_bad_request.json",
+			},
+			out: struct {
+				workspace *Workspace
+				err       error
+			}{
+				workspace: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Workspace name must be present",
+					Body:       "\"Workspace name must be present\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/create_workspace_401_unauthorized",
+			},
+			out: struct {
+				workspace *Workspace
+				err       error
+			}{
+				workspace: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "402 Payment Required",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusPaymentRequired,
+				testdataFile: "testdata/workspaces/create_workspace_402_payment_required.json",
+			},
+			out: struct {
+				workspace *Workspace
+				err       error
+			}{
+				workspace: nil,
+				err: &track.APIError{
+					StatusCode: 402,
+					Message:    "Maximum number of workspaces has been reached",
+					Body:       "\"Maximum number of workspaces has been reached\"\n",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/create_workspace_403_forbidden",
+			},
+			out: struct {
+				workspace *Workspace
+				err       error
+			}{
+				workspace: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organizationID := 1234567
+			apiSpecificPath := path.Join(organizationsPath, strconv.Itoa(organizationID), "workspaces")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspace, err := apiClient.CreateWorkspace(context.Background(), organizationID, &CreateWorkspaceRequestBody{Name: track.Ptr("workspace")})
+
+			if !reflect.DeepEqual(workspace, tt.out.workspace) {
+				internal.Errorf(t, workspace, tt.out.workspace)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateWorkspaceRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateWorkspaceRequestBody
+		out  string
+	}{
+		{
+			name: "string",
+			in: &CreateWorkspaceRequestBody{
+				Name: track.Ptr("Sandbox Workspace"),
+			},
+			out: "{\"name\":\"Sandbox Workspace\"}",
+		},
+		{
+			name: "string, int, bool, and slice",
+			in: &CreateWorkspaceRequestBody{
+				Admins:                      []*int{track.Ptr(3456789)},
+				DefaultCurrency:             track.Ptr("USD"),
+				DefaultHourlyRate:           track.Ptr(100),
+				Name:                        track.Ptr("Sandbox Workspace"),
+				OnlyAdminsMayCreateProjects: track.Ptr(true),
+			},
+			out: "{\"admins\":[3456789],\"default_currency\":\"USD\",\"default_hourly_rate\":100,\"name\":\"Sandbox Workspace\",\"only_admins_may_create_projects\":true}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			organizationID := 1234567
+			_, _ = apiClient.CreateWorkspace(context.Background(), organizationID, tt.in)
+		})
+	}
+}
+
+func TestGetWorkspaceLogo(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			workspaceLogo *WorkspaceLogo
+			err           error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/get_workspace_logo_200_ok.json",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: &WorkspaceLogo{
+					Logo: track.Ptr("https://assets.track.toggl.com/logos/abcdef.png"),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/get_workspace_logo_401_unauthorized",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/get_workspace_logo_403_forbidden",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/workspaces/get_workspace_logo_404_not_found.json",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceLogo, err := apiClient.GetWorkspaceLogo(context.Background(), workspaceID)
+
+			if !reflect.DeepEqual(workspaceLogo, tt.out.workspaceLogo) {
+				internal.Errorf(t, workspaceLogo, tt.out.workspaceLogo)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUploadWorkspaceLogo(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			workspaceLogo *WorkspaceLogo
+			err           error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/upload_workspace_logo_200_ok.json",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: &WorkspaceLogo{
+					Logo: track.Ptr("https://assets.track.toggl.com/logos/abcdef.png"),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/workspaces/upload_workspace_logo_400_bad_request.json",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid file type",
+					Body:       "\"Invalid file type\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/upload_workspace_logo_401_unauthorized",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/upload_workspace_logo_403_forbidden",
+			},
+			out: struct {
+				workspaceLogo *WorkspaceLogo
+				err           error
+			}{
+				workspaceLogo: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceLogo, err := apiClient.UploadWorkspaceLogo(context.Background(), workspaceID, "logo.png", strings.NewReader("PNG"))
+
+			if !reflect.DeepEqual(workspaceLogo, tt.out.workspaceLogo) {
+				internal.Errorf(t, workspaceLogo, tt.out.workspaceLogo)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUploadWorkspaceLogoRequestBody(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, fileHeader, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		if fileHeader.Filename != "logo.png" || string(content) != "PNG" {
+			internal.Errorf(t, []string{fileHeader.Filename, string(content)}, []string{"logo.png", "PNG"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	}))
+	defer mockServer.Close()
+
+	apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+	workspaceID := 2345678
+	if _, err := apiClient.UploadWorkspaceLogo(context.Background(), workspaceID, "logo.png", strings.NewReader("PNG")); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestDeleteWorkspaceLogo(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/delete_workspace_logo_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/delete_workspace_logo_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/delete_workspace_logo_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/workspaces/delete_workspace_logo_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Resource can not be found",
+					Body:       "\"Resource can not be found\"\n",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "logo")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.DeleteWorkspaceLogo(context.Background(), workspaceID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetWorkspacePreferences(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			workspacePreferences *WorkspacePreferences
+			err                  error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/get_workspace_preferences_200_ok.json",
+			},
+			out: struct {
+				workspacePreferences *WorkspacePreferences
+				err                  error
+			}{
+				workspacePreferences: &WorkspacePreferences{
+					HideStartEndTimes: track.Ptr(true),
+					ReportLockedAt:    track.Ptr("2022-01-31"),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/get_workspace_preferences_401_unauthorized",
+			},
+			out: struct {
+				workspacePreferences *WorkspacePreferences
+				err                  error
+			}{
+				workspacePreferences: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/get_workspace_preferences_403_forbidden",
+			},
+			out: struct {
+				workspacePreferences *WorkspacePreferences
+				err                  error
+			}{
+				workspacePreferences: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "preferences")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspacePreferences, err := apiClient.GetWorkspacePreferences(context.Background(), workspaceID)
+
+			if !reflect.DeepEqual(workspacePreferences, tt.out.workspacePreferences) {
+				internal.Errorf(t, workspacePreferences, tt.out.workspacePreferences)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateWorkspacePreferences(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/workspaces/update_workspace_preferences_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/workspaces/update_workspace_preferences_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "JSON is not valid",
+					Body:       "\"JSON is not valid\"\n",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/workspaces/update_workspace_preferences_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/workspaces/update_workspace_preferences_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(workspacesPath, strconv.Itoa(workspaceID), "preferences")
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			err := apiClient.UpdateWorkspacePreferences(context.Background(), workspaceID, &UpdateWorkspacePreferencesRequestBody{})
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateWorkspacePreferencesRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *UpdateWorkspacePreferencesRequestBody
+		out  string
+	}{
+		{
+			name: "bool",
+			in: &UpdateWorkspacePreferencesRequestBody{
+				HideStartEndTimes: track.Ptr(false),
+			},
+			out: "{\"hide_start_end_times\":false}",
+		},
+		{
+			name: "bool and string",
+			in: &UpdateWorkspacePreferencesRequestBody{
+				HideStartEndTimes: track.Ptr(true),
+				ReportLockedAt:    track.NewNullable("2022-01-31"),
+			},
+			out: "{\"hide_start_end_times\":true,\"report_locked_at\":\"2022-01-31\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(WithAPIToken(internal.APIToken), withBaseURL(mockServer.URL))
+			workspaceID := 2345678
+			_ = apiClient.UpdateWorkspacePreferences(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
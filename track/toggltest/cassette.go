@@ -0,0 +1,315 @@
+package toggltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	redacted      string = "[REDACTED]"
+	redactedEmail string = "redacted@example.com"
+)
+
+var (
+	emailPattern    = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	apiTokenPattern = regexp.MustCompile(`"api_token"\s*:\s*"[^"]*"`)
+
+	// sensitiveHeaders are removed from cassettes except Authorization, whose value is redacted.
+	sensitiveHeaders = []string{"Cookie", "Set-Cookie"}
+)
+
+// Cassette represents the interactions with Toggl API recorded by Recorder.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction represents a pair of a request and its response.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest represents a sanitized request in a cassette.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse represents a sanitized response in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from the file.
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read cassette")
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(b, cassette); err != nil {
+		return nil, errors.Wrap(err, "failed to decode cassette")
+	}
+	return cassette, nil
+}
+
+// Save writes the cassette to the file, creating the parent directories if necessary.
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode cassette")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create directory of cassette")
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o644); err != nil {
+		return errors.Wrap(err, "failed to write cassette")
+	}
+	return nil
+}
+
+// Recorder is an http.RoundTripper which records interactions with the real Toggl API.
+// The API token and email addresses are scrubbed from the recorded interactions.
+// It is safe for concurrent use by multiple goroutines.
+type Recorder struct {
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+// NewRecorder creates a recorder which saves the cassette to the path.
+// Requests are sent by the transport, or http.DefaultTransport if it's nil.
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{path: path, transport: transport, cassette: &Cassette{}}
+}
+
+// Client returns an HTTP client which records interactions, which can be passed to WithHTTPClient of API clients.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAll(req.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read request body")
+	}
+	// RoundTrip must not modify the request, so a copy with the same body is sent instead.
+	outReq := req.Clone(req.Context())
+	outReq.Body = io.NopCloser(bytes.NewReader(reqBody))
+	resp, err := r.transport.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	s := newSanitizer(req)
+	interaction := &Interaction{
+		Request: s.request(req, reqBody),
+		Response: &RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     s.header(resp.Header),
+			Body:       s.body(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return resp, nil
+}
+
+// Save writes the interactions recorded so far to the cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// MatchMode specifies how Replayer matches requests with recorded interactions.
+type MatchMode int
+
+const (
+	// MatchStrict matches the method, the URL, and the body.
+	// JSON bodies match if they are equivalent regardless of the order of object keys.
+	MatchStrict MatchMode = iota
+	// MatchIgnoreBody matches only the method and the URL.
+	MatchIgnoreBody
+)
+
+// Replayer is an http.RoundTripper which serves responses from a cassette without network access.
+// Each recorded interaction is served only once, in the order of the cassette.
+// It is safe for concurrent use by multiple goroutines.
+type Replayer struct {
+	mode MatchMode
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer creates a replayer which serves the cassette at the path.
+func NewReplayer(path string, mode MatchMode) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create replayer")
+	}
+	return &Replayer{mode: mode, cassette: cassette, used: make([]bool, len(cassette.Interactions))}, nil
+}
+
+// Client returns an HTTP client which replays interactions, which can be passed to WithHTTPClient of API clients.
+func (r *Replayer) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+// It returns an error if no recorded interaction matches the request.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAll(req.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read request body")
+	}
+	got := newSanitizer(req).request(req, reqBody)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var candidate *RecordedRequest
+	for i, interaction := range r.cassette.Interactions {
+		want := interaction.Request
+		if r.used[i] || want.Method != got.Method || want.URL != got.URL {
+			continue
+		}
+		if r.mode == MatchStrict && !equalBody(want.Body, got.Body) {
+			candidate = want
+			continue
+		}
+		r.used[i] = true
+		return newResponse(req, interaction.Response), nil
+	}
+
+	if candidate != nil {
+		return nil, fmt.Errorf("toggltest: the body of %s %s doesn't match the cassette\nwant: %s\ngot : %s", got.Method, got.URL, candidate.Body, got.Body)
+	}
+	return nil, fmt.Errorf("toggltest: no recorded interaction matches %s %s", got.Method, got.URL)
+}
+
+// Unused returns the recorded interactions which haven't been replayed yet.
+func (r *Replayer) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []*Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func newResponse(req *http.Request, recorded *RecordedResponse) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+func equalBody(want, got string) bool {
+	if want == got {
+		return true
+	}
+	var wantJSON, gotJSON any
+	if json.Unmarshal([]byte(want), &wantJSON) != nil || json.Unmarshal([]byte(got), &gotJSON) != nil {
+		return false
+	}
+	return reflect.DeepEqual(wantJSON, gotJSON)
+}
+
+// readAll reads and closes the body, which may be nil.
+func readAll(body io.ReadCloser) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// sanitizer scrubs the API token used by a request and email addresses.
+// The same request is sanitized in the same way when it's recorded and replayed, so that they can be compared.
+type sanitizer struct {
+	apiToken string
+}
+
+func newSanitizer(req *http.Request) *sanitizer {
+	apiToken, _, _ := req.BasicAuth()
+	return &sanitizer{apiToken: apiToken}
+}
+
+func (s *sanitizer) request(req *http.Request, body []byte) *RecordedRequest {
+	return &RecordedRequest{
+		Method: req.Method,
+		URL:    s.string(req.URL.String()),
+		Header: s.header(req.Header),
+		Body:   s.body(body),
+	}
+}
+
+func (s *sanitizer) header(header http.Header) http.Header {
+	sanitized := http.Header{}
+	for key, values := range header {
+		key = http.CanonicalHeaderKey(key)
+		switch {
+		case key == "Authorization":
+			sanitized[key] = []string{redacted}
+		case slices.Contains(sensitiveHeaders, key):
+		default:
+			for _, value := range values {
+				sanitized[key] = append(sanitized[key], s.string(value))
+			}
+		}
+	}
+	return sanitized
+}
+
+func (s *sanitizer) body(body []byte) string {
+	return apiTokenPattern.ReplaceAllString(s.string(string(body)), `"api_token":`+strconv.Quote(redacted))
+}
+
+func (s *sanitizer) string(value string) string {
+	if s.apiToken != "" {
+		value = strings.ReplaceAll(value, s.apiToken, redacted)
+	}
+	return emailPattern.ReplaceAllString(value, redactedEmail)
+}
@@ -0,0 +1,129 @@
+package toggltest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+)
+
+const secretAPIToken string = "1971800d4d82861d8f2c1651fea4d212"
+
+func recordCassette(t *testing.T) (string, int) {
+	server := NewServer(WithAPIToken(secretAPIToken))
+	defer server.Close()
+	workspace := server.AddWorkspace(&toggl.Workspace{Name: track.Ptr("Workspace")})
+	server.SetMe(&toggl.Me{ID: track.Ptr(1), APIToken: track.Ptr(secretAPIToken), Email: track.Ptr("toggl@example.org")})
+
+	cassettePath := filepath.Join(t.TempDir(), "fixtures", "cassette.json")
+	recorder := NewRecorder(cassettePath, server.Client().Transport)
+	apiClient := toggl.NewAPIClient(toggl.WithAPIToken(secretAPIToken), toggl.WithHTTPClient(recorder.Client()))
+	ctx := context.Background()
+	if _, err := apiClient.GetMe(ctx); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := apiClient.CreateProject(ctx, *workspace.ID, &toggl.CreateProjectRequestBody{Name: track.Ptr("Project")}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err.Error())
+	}
+	return cassettePath, *workspace.ID
+}
+
+func TestRecorderScrubsSecrets(t *testing.T) {
+	cassettePath, _ := recordCassette(t)
+
+	b, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, secret := range []string{secretAPIToken, "toggl@example.org", "Basic "} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, b)
+		}
+	}
+	cassette, err := LoadCassette(cassettePath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(cassette.Interactions) != 2 {
+		internal.Errorf(t, len(cassette.Interactions), 2)
+	}
+}
+
+func TestReplayer(t *testing.T) {
+	cassettePath, workspaceID := recordCassette(t)
+	tests := []struct {
+		name string
+		in   struct {
+			mode        MatchMode
+			projectName string
+		}
+		out bool
+	}{
+		{
+			name: "strict mode with the same body",
+			in: struct {
+				mode        MatchMode
+				projectName string
+			}{mode: MatchStrict, projectName: "Project"},
+			out: true,
+		},
+		{
+			name: "strict mode with a changed body",
+			in: struct {
+				mode        MatchMode
+				projectName string
+			}{mode: MatchStrict, projectName: "Renamed Project"},
+			out: false,
+		},
+		{
+			name: "mode ignoring body with a changed body",
+			in: struct {
+				mode        MatchMode
+				projectName string
+			}{mode: MatchIgnoreBody, projectName: "Renamed Project"},
+			out: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replayer, err := NewReplayer(cassettePath, tt.in.mode)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			apiClient := toggl.NewAPIClient(toggl.WithAPIToken(secretAPIToken), toggl.WithHTTPClient(replayer.Client()))
+			ctx := context.Background()
+
+			me, err := apiClient.GetMe(ctx)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if *me.ID != 1 || *me.Email != redactedEmail || *me.APIToken != redacted {
+				internal.Errorf(t, me, "the sanitized user")
+			}
+
+			project, err := apiClient.CreateProject(ctx, workspaceID, &toggl.CreateProjectRequestBody{Name: track.Ptr(tt.in.projectName)})
+			if (err == nil) != tt.out {
+				internal.Errorf(t, err, tt.out)
+			}
+			if tt.out && *project.Name != "Project" {
+				internal.Errorf(t, *project.Name, "Project")
+			}
+			if unused := replayer.Unused(); tt.out && len(unused) != 0 {
+				internal.Errorf(t, unused, nil)
+			}
+
+			// Every interaction is replayed only once.
+			if _, err := apiClient.GetMe(ctx); err == nil {
+				internal.Errorf(t, err, "no recorded interaction matches")
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package toggltest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/reports"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+)
+
+// detailedReportRow has the same JSON representation as reports.DetailedReportRow, which has unexported types.
+type detailedReportRow struct {
+	UserID      *int                       `json:"user_id,omitempty"`
+	Username    *string                    `json:"username,omitempty"`
+	ProjectID   *int                       `json:"project_id,omitempty"`
+	TaskID      *int                       `json:"task_id,omitempty"`
+	Billable    *bool                      `json:"billable,omitempty"`
+	Description *string                    `json:"description,omitempty"`
+	TagIDs      []*int                     `json:"tag_ids,omitempty"`
+	TimeEntries []*detailedReportTimeEntry `json:"time_entries,omitempty"`
+	RowNumber   *int                       `json:"row_number,omitempty"`
+}
+
+type detailedReportTimeEntry struct {
+	ID      *int       `json:"id,omitempty"`
+	Seconds *int       `json:"seconds,omitempty"`
+	Start   *time.Time `json:"start,omitempty"`
+	Stop    *time.Time `json:"stop,omitempty"`
+	At      *time.Time `json:"at,omitempty"`
+}
+
+func (s *Server) registerReportsHandlers() {
+	s.mux.HandleFunc("POST /reports/api/v3/workspace/{workspace_id}/search/time_entries", s.searchDetailedReport)
+}
+
+// searchDetailedReport returns a row for each time entry ordered by the start,
+// and the cursor of the next page in X-Next-ID, X-Next-Row-Number, and X-Next-Timestamp headers.
+func (s *Server) searchDetailedReport(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	reqBody := &reports.SearchDetailedReportRequestBody{}
+	if !decodeBody(w, r, reqBody) {
+		return
+	}
+	startDate, startOK := parseDate(deref(reqBody.StartDate))
+	endDate, endOK := parseDate(deref(reqBody.EndDate))
+	if (reqBody.StartDate != nil && !startOK) || (reqBody.EndDate != nil && !endOK) {
+		writeError(w, http.StatusBadRequest, "Invalid date format")
+		return
+	}
+
+	timeEntries := values(s.timeEntries, func(te *toggl.TimeEntry) bool {
+		switch {
+		case *te.WorkspaceID != workspaceID:
+			return false
+		case startOK && te.Start.Before(startDate):
+			return false
+		case endOK && !te.Start.Before(endDate.AddDate(0, 0, 1)): // end_date is inclusive.
+			return false
+		case reqBody.Billable != nil && *te.Billable != *reqBody.Billable:
+			return false
+		case reqBody.Description != nil && !strings.Contains(deref(te.Description), *reqBody.Description):
+			return false
+		case len(reqBody.ProjectIDs) > 0 && !containsInt(reqBody.ProjectIDs, te.ProjectID):
+			return false
+		case len(reqBody.TimeEntryIDs) > 0 && !containsInt(reqBody.TimeEntryIDs, te.ID):
+			return false
+		}
+		return len(reqBody.TagIDs) == 0 || slices.ContainsFunc(te.TagIDs, func(tagID *int) bool {
+			return containsInt(reqBody.TagIDs, tagID)
+		})
+	})
+	slices.SortStableFunc(timeEntries, func(a, b *toggl.TimeEntry) int {
+		return a.Start.Compare(*b.Start)
+	})
+
+	first := 1
+	if reqBody.FirstRowNumber != nil && *reqBody.FirstRowNumber > 0 {
+		first = *reqBody.FirstRowNumber
+	}
+	rows := []*detailedReportRow{}
+	for rowNumber := first; rowNumber <= len(timeEntries) && rowNumber < first+s.detailedReportPageSize; rowNumber++ {
+		te := timeEntries[rowNumber-1]
+		rows = append(rows, &detailedReportRow{
+			UserID:      te.UserID,
+			Username:    s.me.Fullname,
+			ProjectID:   te.ProjectID,
+			TaskID:      te.TaskID,
+			Billable:    te.Billable,
+			Description: te.Description,
+			TagIDs:      te.TagIDs,
+			TimeEntries: []*detailedReportTimeEntry{{
+				ID:      te.ID,
+				Seconds: track.Ptr(int(te.Elapsed().Seconds())),
+				Start:   te.Start,
+				Stop:    te.Stop,
+				At:      te.At,
+			}},
+			RowNumber: track.Ptr(rowNumber),
+		})
+	}
+	if next := first + s.detailedReportPageSize; next <= len(timeEntries) {
+		te := timeEntries[next-1]
+		w.Header().Set("X-Next-ID", strconv.Itoa(*te.ID))
+		w.Header().Set("X-Next-Row-Number", strconv.Itoa(next))
+		w.Header().Set("X-Next-Timestamp", strconv.FormatInt(te.Start.Unix(), 10))
+	}
+	writeJSON(w, http.StatusOK, rows)
+}
+
+func containsInt(ids []*int, id *int) bool {
+	return id != nil && slices.ContainsFunc(ids, func(i *int) bool { return i != nil && *i == *id })
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
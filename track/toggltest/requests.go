@@ -0,0 +1,97 @@
+package toggltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// Request represents a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// DecodeBody decodes the JSON request body into v.
+func (r *Request) DecodeBody(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Requests returns all the requests received by the server in the order of arrival.
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests matching the method and the path in the order of arrival.
+func (s *Server) RequestsTo(method, path string) []*Request {
+	var requests []*Request
+	for _, r := range s.Requests() {
+		if r.Method == method && r.Path == path {
+			requests = append(requests, r)
+		}
+	}
+	return requests
+}
+
+// ResetRequests forgets the requests received so far.
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+// AssertRequested fails the test if the server hasn't received a request matching the method and the path.
+// It returns the last matching request to examine it further.
+func (s *Server) AssertRequested(t testing.TB, method, path string) *Request {
+	t.Helper()
+	requests := s.RequestsTo(method, path)
+	if len(requests) == 0 {
+		t.Errorf("toggltest: %s %s was not requested", method, path)
+		return nil
+	}
+	return requests[len(requests)-1]
+}
+
+// AssertNotRequested fails the test if the server has received a request matching the method and the path.
+func (s *Server) AssertNotRequested(t testing.TB, method, path string) {
+	t.Helper()
+	if n := len(s.RequestsTo(method, path)); n > 0 {
+		t.Errorf("toggltest: %s %s was requested %d times, want none", method, path, n)
+	}
+}
+
+// AssertRequestCount fails the test if the number of requests matching the method and the path is not count.
+func (s *Server) AssertRequestCount(t testing.TB, method, path string, count int) {
+	t.Helper()
+	if n := len(s.RequestsTo(method, path)); n != count {
+		t.Errorf("toggltest: %s %s was requested %d times, want %d", method, path, n, count)
+	}
+}
+
+// AssertRequestBody fails the test if the body of the last request matching the method and the path
+// is not equivalent to the JSON want. The order of object keys and whitespaces are ignored.
+func (s *Server) AssertRequestBody(t testing.TB, method, path, want string) {
+	t.Helper()
+	r := s.AssertRequested(t, method, path)
+	if r == nil {
+		return
+	}
+	var gotJSON, wantJSON any
+	if err := json.Unmarshal(r.Body, &gotJSON); err != nil {
+		t.Errorf("toggltest: the body of %s %s is not valid JSON: %s", method, path, r.Body)
+		return
+	}
+	if err := json.Unmarshal([]byte(want), &wantJSON); err != nil {
+		t.Fatalf("toggltest: want is not valid JSON: %s", want)
+	}
+	if !reflect.DeepEqual(gotJSON, wantJSON) {
+		t.Errorf("toggltest: unexpected body of %s %s\nwant: %s\ngot : %s\n", method, path, want, r.Body)
+	}
+}
@@ -0,0 +1,966 @@
+package toggltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+)
+
+// AddWorkspace adds a workspace to the server, and returns the stored copy.
+// The ID is assigned if it's nil, and the first workspace becomes the default workspace of the user.
+func (s *Server) AddWorkspace(workspace *toggl.Workspace) *toggl.Workspace {
+	requireField("AddWorkspace", "workspace", workspace != nil)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w := clone(workspace)
+	if w.ID == nil {
+		w.ID = track.Ptr(s.nextID())
+	}
+	if w.At == nil {
+		w.At = s.timestamp()
+	}
+	s.workspaces[*w.ID] = w
+	if s.me.DefaultWorkspaceID == nil {
+		s.me.DefaultWorkspaceID = track.Ptr(*w.ID)
+	}
+	return clone(w)
+}
+
+// AddProject adds a project to the server, and returns the stored copy.
+// WorkspaceID must be set, and the ID is assigned if it's nil.
+// It panics if WorkspaceID isn't set.
+func (s *Server) AddProject(project *toggl.Project) *toggl.Project {
+	requireField("AddProject", "project", project != nil)
+	requireField("AddProject", "WorkspaceID", project.WorkspaceID != nil || project.WID != nil)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := clone(project)
+	if p.ID == nil {
+		p.ID = track.Ptr(s.nextID())
+	}
+	s.normalizeProject(p, &toggl.Project{})
+	s.projects[*p.ID] = p
+	return clone(p)
+}
+
+// AddClient adds a client to the server, and returns the stored copy.
+// WID must be set, and the ID is assigned if it's nil.
+// It panics if WID isn't set.
+func (s *Server) AddClient(client *toggl.Client) *toggl.Client {
+	requireField("AddClient", "client", client != nil)
+	requireField("AddClient", "WID", client.WID != nil)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := clone(client)
+	if c.ID == nil {
+		c.ID = track.Ptr(s.nextID())
+	}
+	if c.At == nil {
+		c.At = s.timestamp()
+	}
+	s.clients[*c.ID] = c
+	return clone(c)
+}
+
+// AddTag adds a tag to the server, and returns the stored copy.
+// WorkspaceID and Name must be set, and the ID is assigned if it's nil.
+// It panics if WorkspaceID or Name isn't set.
+func (s *Server) AddTag(tag *toggl.Tag) *toggl.Tag {
+	requireField("AddTag", "tag", tag != nil)
+	requireField("AddTag", "WorkspaceID", tag.WorkspaceID != nil)
+	requireField("AddTag", "Name", tag.Name != nil)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := clone(tag)
+	if t.ID == nil {
+		t.ID = track.Ptr(s.nextID())
+	}
+	if t.At == nil {
+		t.At = s.timestamp()
+	}
+	s.tags[*t.ID] = t
+	return clone(t)
+}
+
+// AddTimeEntry adds a time entry of the user to the server, and returns the stored copy.
+// WorkspaceID must be set, and the ID is assigned if it's nil. Start is the current time if it's nil.
+// The time entry is running if Duration is negative. It panics if WorkspaceID isn't set.
+func (s *Server) AddTimeEntry(timeEntry *toggl.TimeEntry) *toggl.TimeEntry {
+	requireField("AddTimeEntry", "timeEntry", timeEntry != nil)
+	requireField("AddTimeEntry", "WorkspaceID", timeEntry.WorkspaceID != nil || timeEntry.WID != nil)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	te := clone(timeEntry)
+	if te.ID == nil {
+		te.ID = track.Ptr(s.nextID())
+	}
+	s.normalizeTimeEntry(te, &toggl.TimeEntry{})
+	s.timeEntries[*te.ID] = te
+	return clone(te)
+}
+
+// Me returns the current user of the server.
+func (s *Server) Me() *toggl.Me {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return clone(s.me)
+}
+
+// SetMe replaces the current user of the server.
+func (s *Server) SetMe(me *toggl.Me) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.me = clone(me)
+}
+
+// Projects returns all the projects in the server ordered by ID.
+func (s *Server) Projects() []*toggl.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.projects, func(p *toggl.Project) bool { return true })
+}
+
+// Clients returns all the clients in the server ordered by ID.
+func (s *Server) Clients() []*toggl.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.clients, func(c *toggl.Client) bool { return true })
+}
+
+// Tags returns all the tags in the server ordered by ID.
+func (s *Server) Tags() []*toggl.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.tags, func(t *toggl.Tag) bool { return true })
+}
+
+// TimeEntries returns all the time entries in the server ordered by ID.
+func (s *Server) TimeEntries() []*toggl.TimeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return values(s.timeEntries, func(te *toggl.TimeEntry) bool { return true })
+}
+
+// values returns copies of the resources which satisfy the condition ordered by ID.
+func values[T any](resources map[int]*T, condition func(*T) bool) []*T {
+	ids := make([]int, 0, len(resources))
+	for id, resource := range resources {
+		if condition(resource) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	result := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, clone(resources[id]))
+	}
+	return result
+}
+
+func (s *Server) registerTogglHandlers() {
+	s.mux.HandleFunc("GET /api/v9/me", s.getMe)
+	s.mux.HandleFunc("GET /api/v9/me/projects", s.getMyProjects)
+	s.mux.HandleFunc("GET /api/v9/me/clients", s.getMyClients)
+	s.mux.HandleFunc("GET /api/v9/me/tags", s.getMyTags)
+	s.mux.HandleFunc("GET /api/v9/me/time_entries", s.getTimeEntries)
+	s.mux.HandleFunc("GET /api/v9/me/time_entries/current", s.getCurrentTimeEntry)
+
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}", s.getWorkspace)
+	s.mux.HandleFunc("PUT /api/v9/workspaces/{workspace_id}", s.updateWorkspace)
+
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}/projects", s.getProjects)
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/projects", s.createProject)
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}/projects/{project_id}", s.getProject)
+	s.mux.HandleFunc("PUT /api/v9/workspaces/{workspace_id}/projects/{project_id}", s.updateProject)
+	s.mux.HandleFunc("PATCH /api/v9/workspaces/{workspace_id}/projects/{project_ids}", s.patchProjects)
+	s.mux.HandleFunc("DELETE /api/v9/workspaces/{workspace_id}/projects/{project_id}", s.deleteProject)
+
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}/clients", s.getClients)
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/clients", s.createClient)
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}/clients/{client_id}", s.getClient)
+	s.mux.HandleFunc("PUT /api/v9/workspaces/{workspace_id}/clients/{client_id}", s.updateClient)
+	s.mux.HandleFunc("DELETE /api/v9/workspaces/{workspace_id}/clients/{client_id}", s.deleteClient)
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/clients/{client_id}/archive", s.archiveClient)
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/clients/{client_id}/restore", s.restoreClient)
+
+	s.mux.HandleFunc("GET /api/v9/workspaces/{workspace_id}/tags", s.getTags)
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/tags", s.createTag)
+	s.mux.HandleFunc("PUT /api/v9/workspaces/{workspace_id}/tags/{tag_id}", s.updateTag)
+	s.mux.HandleFunc("DELETE /api/v9/workspaces/{workspace_id}/tags/{tag_id}", s.deleteTag)
+
+	s.mux.HandleFunc("POST /api/v9/workspaces/{workspace_id}/time_entries", s.createTimeEntry)
+	s.mux.HandleFunc("PUT /api/v9/workspaces/{workspace_id}/time_entries/{time_entry_id}", s.updateTimeEntry)
+	s.mux.HandleFunc("PATCH /api/v9/workspaces/{workspace_id}/time_entries/{time_entry_ids}", s.patchTimeEntries)
+	s.mux.HandleFunc("PATCH /api/v9/workspaces/{workspace_id}/time_entries/{time_entry_id}/stop", s.stopTimeEntry)
+	s.mux.HandleFunc("DELETE /api/v9/workspaces/{workspace_id}/time_entries/{time_entry_id}", s.deleteTimeEntry)
+}
+
+func (s *Server) getMe(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.me)
+}
+
+func (s *Server) getMyProjects(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, values(s.projects, func(p *toggl.Project) bool { return true }))
+}
+
+func (s *Server) getMyClients(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, values(s.clients, func(c *toggl.Client) bool { return true }))
+}
+
+func (s *Server) getMyTags(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, values(s.tags, func(t *toggl.Tag) bool { return true }))
+}
+
+// workspaceID parses the workspace ID in the path, and writes an error if the workspace doesn't exist.
+func (s *Server) workspaceID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	workspaceID, ok := pathInt(w, r, "workspace_id")
+	if !ok {
+		return 0, false
+	}
+	if _, ok := s.workspaces[workspaceID]; !ok {
+		writeError(w, http.StatusNotFound, "Workspace not found")
+		return 0, false
+	}
+	return workspaceID, true
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Resource can not be found")
+}
+
+func (s *Server) getWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.workspaces[workspaceID])
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	workspace := clone(s.workspaces[workspaceID])
+	if !decodeBody(w, r, workspace) {
+		return
+	}
+	workspace.ID = track.Ptr(workspaceID)
+	workspace.At = s.timestamp()
+	s.workspaces[workspaceID] = workspace
+	writeJSON(w, http.StatusOK, workspace)
+}
+
+// syncAlias keeps a field and its legacy alias such as project_id and pid in sync.
+// The alias takes precedence only if it was changed from oldAlias.
+func syncAlias(field, alias **int, oldAlias *int) {
+	if !reflect.DeepEqual(*alias, oldAlias) {
+		*field = *alias
+	}
+	*alias = *field
+}
+
+func (s *Server) normalizeProject(p, old *toggl.Project) {
+	syncAlias(&p.WorkspaceID, &p.WID, old.WID)
+	syncAlias(&p.ClientID, &p.CID, old.CID)
+	if p.Active == nil {
+		p.Active = track.Ptr(true)
+	}
+	if p.CreatedAt == nil {
+		p.CreatedAt = s.timestamp()
+	}
+	p.At = s.timestamp()
+}
+
+func (s *Server) getProjects(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	active := query.Get("active")
+	name := strings.ToLower(query.Get("name"))
+	projects := values(s.projects, func(p *toggl.Project) bool {
+		if *p.WorkspaceID != workspaceID {
+			return false
+		}
+		if active != "" && active != "both" && strconv.FormatBool(*p.Active) != active {
+			return false
+		}
+		return name == "" || (p.Name != nil && strings.Contains(strings.ToLower(*p.Name), name))
+	})
+	writeJSON(w, http.StatusOK, paginate(projects, query.Get("page"), query.Get("per_page")))
+}
+
+// paginate returns the page of the resources in the same way as Toggl API, whose default page size is 151.
+func paginate[T any](resources []*T, page, perPage string) []*T {
+	p, err := strconv.Atoi(page)
+	if err != nil || p < 1 {
+		p = 1
+	}
+	size, err := strconv.Atoi(perPage)
+	if err != nil || size < 1 {
+		size = 151
+	}
+	start := min((p-1)*size, len(resources))
+	end := min(start+size, len(resources))
+	return resources[start:end]
+}
+
+func (s *Server) project(w http.ResponseWriter, r *http.Request, workspaceID int) (*toggl.Project, bool) {
+	projectID, ok := pathInt(w, r, "project_id")
+	if !ok {
+		return nil, false
+	}
+	project, ok := s.projects[projectID]
+	if !ok || *project.WorkspaceID != workspaceID {
+		writeNotFound(w)
+		return nil, false
+	}
+	return project, true
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if project, ok := s.project(w, r, workspaceID); ok {
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+func (s *Server) hasProjectNamed(workspaceID, exceptID int, name *string) bool {
+	for _, p := range s.projects {
+		if *p.WorkspaceID == workspaceID && *p.ID != exceptID && p.Name != nil && name != nil && *p.Name == *name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	project := &toggl.Project{}
+	if !decodeBody(w, r, project) {
+		return
+	}
+	if project.Name == nil || *project.Name == "" {
+		writeError(w, http.StatusBadRequest, "Name must be present")
+		return
+	}
+	if s.hasProjectNamed(workspaceID, 0, project.Name) {
+		writeError(w, http.StatusBadRequest, "Name has already been taken")
+		return
+	}
+	project.ID = track.Ptr(s.nextID())
+	project.WorkspaceID, project.WID = track.Ptr(workspaceID), nil
+	s.normalizeProject(project, &toggl.Project{})
+	s.projects[*project.ID] = project
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	old, ok := s.project(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	project := clone(old)
+	if !decodeBody(w, r, project) {
+		return
+	}
+	if s.hasProjectNamed(workspaceID, *old.ID, project.Name) {
+		writeError(w, http.StatusBadRequest, "Name has already been taken")
+		return
+	}
+	project.ID, project.WorkspaceID, project.WID = old.ID, old.WorkspaceID, old.WID
+	s.normalizeProject(project, old)
+	s.projects[*project.ID] = project
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) patchProjects(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	var operations []*toggl.PatchOperation
+	if !decodeBody(w, r, &operations) {
+		return
+	}
+	result := patchResources(r.PathValue("project_ids"), operations, func(id int) (*toggl.Project, bool) {
+		project, ok := s.projects[id]
+		return project, ok && *project.WorkspaceID == workspaceID
+	}, func(project, old *toggl.Project) {
+		project.ID, project.WorkspaceID, project.WID = old.ID, old.WorkspaceID, old.WID
+		s.normalizeProject(project, old)
+		s.projects[*project.ID] = project
+	})
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if project, ok := s.project(w, r, workspaceID); ok {
+		delete(s.projects, *project.ID)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) getClients(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	status := query.Get("status")
+	name := strings.ToLower(query.Get("name"))
+	clients := values(s.clients, func(c *toggl.Client) bool {
+		if *c.WID != workspaceID {
+			return false
+		}
+		archived := c.Archived != nil && *c.Archived
+		if ((status == "" || status == "active") && archived) || (status == "archived" && !archived) {
+			return false
+		}
+		return name == "" || (c.Name != nil && strings.Contains(strings.ToLower(*c.Name), name))
+	})
+	writeJSON(w, http.StatusOK, clients)
+}
+
+func (s *Server) client(w http.ResponseWriter, r *http.Request, workspaceID int) (*toggl.Client, bool) {
+	clientID, ok := pathInt(w, r, "client_id")
+	if !ok {
+		return nil, false
+	}
+	client, ok := s.clients[clientID]
+	if !ok || *client.WID != workspaceID {
+		writeNotFound(w)
+		return nil, false
+	}
+	return client, true
+}
+
+func (s *Server) getClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if client, ok := s.client(w, r, workspaceID); ok {
+		writeJSON(w, http.StatusOK, client)
+	}
+}
+
+func (s *Server) createClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	client := &toggl.Client{}
+	if !decodeBody(w, r, client) {
+		return
+	}
+	if client.Name == nil || *client.Name == "" {
+		writeError(w, http.StatusBadRequest, "Name must be present")
+		return
+	}
+	client.ID, client.WID, client.At = track.Ptr(s.nextID()), track.Ptr(workspaceID), s.timestamp()
+	s.clients[*client.ID] = client
+	writeJSON(w, http.StatusOK, client)
+}
+
+func (s *Server) updateClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	old, ok := s.client(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	client := clone(old)
+	if !decodeBody(w, r, client) {
+		return
+	}
+	client.ID, client.WID, client.At = old.ID, old.WID, s.timestamp()
+	s.clients[*client.ID] = client
+	writeJSON(w, http.StatusOK, client)
+}
+
+// archiveClient archives the client and its active projects, and responds with the IDs of the archived projects.
+func (s *Server) archiveClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	client, ok := s.client(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	if client.Archived != nil && *client.Archived {
+		writeError(w, http.StatusBadRequest, "Client is already archived")
+		return
+	}
+	client.Archived, client.At = track.Ptr(true), s.timestamp()
+	projectIDs := []int{}
+	for _, p := range s.projects {
+		if *p.WorkspaceID == workspaceID && p.ClientID != nil && *p.ClientID == *client.ID && *p.Active {
+			p.Active, p.At = track.Ptr(false), s.timestamp()
+			projectIDs = append(projectIDs, *p.ID)
+		}
+	}
+	slices.Sort(projectIDs)
+	writeJSON(w, http.StatusOK, projectIDs)
+}
+
+// restoreClient restores the client, and the projects specified in the request body.
+func (s *Server) restoreClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	client, ok := s.client(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	reqBody := &toggl.RestoreClientRequestBody{}
+	if !decodeBody(w, r, reqBody) {
+		return
+	}
+	if client.Archived == nil || !*client.Archived {
+		writeError(w, http.StatusBadRequest, "Client is not archived")
+		return
+	}
+	client.Archived, client.At = track.Ptr(false), s.timestamp()
+	for _, p := range s.projects {
+		if *p.WorkspaceID != workspaceID || p.ClientID == nil || *p.ClientID != *client.ID {
+			continue
+		}
+		if deref(reqBody.RestoreAllProjects) || slices.ContainsFunc(reqBody.Projects, func(id *int) bool { return id != nil && *id == *p.ID }) {
+			p.Active, p.At = track.Ptr(true), s.timestamp()
+		}
+	}
+	writeJSON(w, http.StatusOK, client)
+}
+
+func (s *Server) deleteClient(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if client, ok := s.client(w, r, workspaceID); ok {
+		delete(s.clients, *client.ID)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) getTags(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, values(s.tags, func(t *toggl.Tag) bool { return *t.WorkspaceID == workspaceID }))
+}
+
+func (s *Server) tag(w http.ResponseWriter, r *http.Request, workspaceID int) (*toggl.Tag, bool) {
+	tagID, ok := pathInt(w, r, "tag_id")
+	if !ok {
+		return nil, false
+	}
+	tag, ok := s.tags[tagID]
+	if !ok || *tag.WorkspaceID != workspaceID {
+		writeError(w, http.StatusNotFound, "Tag was not found")
+		return nil, false
+	}
+	return tag, true
+}
+
+func (s *Server) createTag(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	tag := &toggl.Tag{}
+	if !decodeBody(w, r, tag) {
+		return
+	}
+	if tag.Name == nil || *tag.Name == "" {
+		writeError(w, http.StatusBadRequest, "tag name can't be blank")
+		return
+	}
+	for _, t := range s.tags {
+		if *t.WorkspaceID == workspaceID && *t.Name == *tag.Name {
+			writeError(w, http.StatusBadRequest, "Tag already exists in this workspace")
+			return
+		}
+	}
+	tag.ID, tag.WorkspaceID, tag.At = track.Ptr(s.nextID()), track.Ptr(workspaceID), s.timestamp()
+	s.tags[*tag.ID] = tag
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) updateTag(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	old, ok := s.tag(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	tag := clone(old)
+	if !decodeBody(w, r, tag) {
+		return
+	}
+	tag.ID, tag.WorkspaceID, tag.At = old.ID, old.WorkspaceID, s.timestamp()
+	s.tags[*tag.ID] = tag
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if tag, ok := s.tag(w, r, workspaceID); ok {
+		delete(s.tags, *tag.ID)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// normalizeTimeEntry fills the derived fields of the time entry updated from old.
+func (s *Server) normalizeTimeEntry(te, old *toggl.TimeEntry) {
+	syncAlias(&te.WorkspaceID, &te.WID, old.WID)
+	syncAlias(&te.ProjectID, &te.PID, old.PID)
+	syncAlias(&te.TaskID, &te.TID, old.TID)
+	te.UserID, te.UID = s.me.ID, s.me.ID
+	if te.Billable == nil {
+		te.Billable = track.Ptr(false)
+	}
+	if te.Start == nil {
+		te.Start = s.timestamp()
+	}
+
+	switch {
+	case te.Duration != nil && *te.Duration < 0:
+		te.Stop = nil
+		te.Duration = track.Ptr(-int(te.Start.Unix()))
+	case te.Stop != nil && (te.Duration == nil || !reflect.DeepEqual(te.Stop, old.Stop) || !reflect.DeepEqual(te.Start, old.Start)):
+		te.Duration = track.Ptr(int(te.Stop.Sub(*te.Start).Seconds()))
+	case te.Duration != nil:
+		te.Stop = track.Ptr(te.Start.Add(time.Duration(*te.Duration) * time.Second))
+	default:
+		te.Duration = track.Ptr(0)
+		te.Stop = track.Ptr(*te.Start)
+	}
+	te.At = s.timestamp()
+}
+
+func (s *Server) stopRunningTimeEntries() {
+	now := s.timestamp()
+	for _, te := range s.timeEntries {
+		if te.IsRunning() {
+			te.Stop = now
+			te.Duration = track.Ptr(max(0, int(now.Sub(*te.Start).Seconds())))
+			te.At = now
+		}
+	}
+}
+
+func (s *Server) getTimeEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	startDate, startOK := parseDate(query.Get("start_date"))
+	endDate, endOK := parseDate(query.Get("end_date"))
+	if startOK != endOK {
+		writeError(w, http.StatusBadRequest, "Both start_date and end_date must be specified")
+		return
+	}
+	before, beforeOK := parseDate(query.Get("before"))
+	if query.Has("before") && !beforeOK {
+		writeError(w, http.StatusBadRequest, "before must be a date or a timestamp in RFC 3339")
+		return
+	}
+	since, _ := strconv.ParseInt(query.Get("since"), 10, 64)
+	timeEntries := values(s.timeEntries, func(te *toggl.TimeEntry) bool {
+		if startOK && (te.Start.Before(startDate) || !te.Start.Before(endDate)) {
+			return false
+		}
+		if beforeOK && !te.Start.Before(before) {
+			return false
+		}
+		return since == 0 || te.At.Unix() >= since
+	})
+	// Toggl API returns the latest time entries first.
+	slices.SortStableFunc(timeEntries, func(a, b *toggl.TimeEntry) int {
+		return b.Start.Compare(*a.Start)
+	})
+	writeJSON(w, http.StatusOK, timeEntries)
+}
+
+// parseDate parses either a date such as 2006-01-02 or a timestamp in RFC 3339.
+func parseDate(value string) (time.Time, bool) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func (s *Server) getCurrentTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var current *toggl.TimeEntry
+	for _, te := range s.timeEntries {
+		if te.IsRunning() {
+			current = te
+		}
+	}
+	writeJSON(w, http.StatusOK, current)
+}
+
+func (s *Server) timeEntry(w http.ResponseWriter, r *http.Request, workspaceID int) (*toggl.TimeEntry, bool) {
+	timeEntryID, ok := pathInt(w, r, "time_entry_id")
+	if !ok {
+		return nil, false
+	}
+	timeEntry, ok := s.timeEntries[timeEntryID]
+	if !ok || *timeEntry.WorkspaceID != workspaceID {
+		writeError(w, http.StatusNotFound, "Time entry not found")
+		return nil, false
+	}
+	return timeEntry, true
+}
+
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	timeEntry := &toggl.TimeEntry{}
+	if !decodeBody(w, r, timeEntry) {
+		return
+	}
+	if timeEntry.Start == nil {
+		writeError(w, http.StatusBadRequest, "Start time must be specified")
+		return
+	}
+	if timeEntry.IsRunning() {
+		s.stopRunningTimeEntries()
+	}
+	timeEntry.ID = track.Ptr(s.nextID())
+	timeEntry.WorkspaceID, timeEntry.WID = track.Ptr(workspaceID), nil
+	s.normalizeTimeEntry(timeEntry, &toggl.TimeEntry{})
+	s.timeEntries[*timeEntry.ID] = timeEntry
+	writeJSON(w, http.StatusOK, timeEntry)
+}
+
+func (s *Server) updateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	old, ok := s.timeEntry(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	body, err := readBody(r)
+	timeEntry := clone(old)
+	if err != nil || json.Unmarshal(body, timeEntry) != nil {
+		writeError(w, http.StatusBadRequest, "JSON is not valid")
+		return
+	}
+	var tagAction struct {
+		TagAction *string `json:"tag_action"`
+	}
+	json.Unmarshal(body, &tagAction)
+	if tagAction.TagAction != nil {
+		timeEntry.Tags = applyTagAction(*tagAction.TagAction, old.Tags, timeEntry.Tags)
+		timeEntry.TagIDs = applyTagAction(*tagAction.TagAction, old.TagIDs, timeEntry.TagIDs)
+	}
+	if timeEntry.IsRunning() && !old.IsRunning() {
+		s.stopRunningTimeEntries()
+	}
+	timeEntry.ID, timeEntry.WorkspaceID, timeEntry.WID = old.ID, old.WorkspaceID, old.WID
+	s.normalizeTimeEntry(timeEntry, old)
+	s.timeEntries[*timeEntry.ID] = timeEntry
+	writeJSON(w, http.StatusOK, timeEntry)
+}
+
+// applyTagAction adds or deletes the given tags from the current tags.
+// The given tags replace the current tags if the action is neither add nor delete.
+func applyTagAction[T comparable](action string, current, given []*T) []*T {
+	result := slices.Clone(current)
+	switch action {
+	case "add":
+		for _, g := range given {
+			if !slices.ContainsFunc(result, func(c *T) bool { return *c == *g }) {
+				result = append(result, g)
+			}
+		}
+		return result
+	case "delete":
+		return slices.DeleteFunc(result, func(c *T) bool {
+			return slices.ContainsFunc(given, func(g *T) bool { return *c == *g })
+		})
+	}
+	return given
+}
+
+func (s *Server) stopTimeEntry(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	timeEntry, ok := s.timeEntry(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	if !timeEntry.IsRunning() {
+		writeError(w, http.StatusConflict, "Time entry already stopped")
+		return
+	}
+	now := s.timestamp()
+	timeEntry.Stop = now
+	timeEntry.Duration = track.Ptr(max(0, int(now.Sub(*timeEntry.Start).Seconds())))
+	timeEntry.At = now
+	writeJSON(w, http.StatusOK, timeEntry)
+}
+
+func (s *Server) patchTimeEntries(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	var operations []*toggl.PatchOperation
+	if !decodeBody(w, r, &operations) {
+		return
+	}
+	result := patchResources(r.PathValue("time_entry_ids"), operations, func(id int) (*toggl.TimeEntry, bool) {
+		timeEntry, ok := s.timeEntries[id]
+		return timeEntry, ok && *timeEntry.WorkspaceID == workspaceID
+	}, func(timeEntry, old *toggl.TimeEntry) {
+		timeEntry.ID, timeEntry.WorkspaceID, timeEntry.WID = old.ID, old.WorkspaceID, old.WID
+		s.normalizeTimeEntry(timeEntry, old)
+		s.timeEntries[*timeEntry.ID] = timeEntry
+	})
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if timeEntry, ok := s.timeEntry(w, r, workspaceID); ok {
+		delete(s.timeEntries, *timeEntry.ID)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// patchResources applies JSON Patch operations to each resource whose ID is in the comma separated ids.
+// The store function is called with the patched copy and the original resource.
+func patchResources[T any](ids string, operations []*toggl.PatchOperation, find func(int) (*T, bool), store func(patched, old *T)) *toggl.PatchResult {
+	result := &toggl.PatchResult{}
+	for _, s := range strings.Split(ids, ",") {
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			continue
+		}
+		old, ok := find(id)
+		if !ok {
+			result.Failure = append(result.Failure, &toggl.PatchFailure{ID: track.Ptr(id), Message: track.Ptr("Resource can not be found")})
+			continue
+		}
+		patched, err := applyPatch(old, operations)
+		if err != nil {
+			result.Failure = append(result.Failure, &toggl.PatchFailure{ID: track.Ptr(id), Message: track.Ptr(err.Error())})
+			continue
+		}
+		store(patched, old)
+		result.Success = append(result.Success, track.Ptr(id))
+	}
+	return result
+}
+
+type patchError string
+
+func (p patchError) Error() string {
+	return string(p)
+}
+
+// applyPatch returns a patched copy of the resource.
+// Adding to and removing from an array such as /tags add and remove the elements of the value.
+func applyPatch[T any](resource *T, operations []*toggl.PatchOperation) (*T, error) {
+	b, _ := json.Marshal(resource)
+	var fields map[string]any
+	json.Unmarshal(b, &fields)
+
+	for _, operation := range operations {
+		name := strings.TrimPrefix(operation.Path, "/")
+		if name == operation.Path || name == "" || strings.Contains(name, "/") {
+			return nil, patchError("Invalid patch operation")
+		}
+		value := normalizeJSON(operation.Value)
+		current, isArray := fields[name].([]any)
+		values, valueIsArray := value.([]any)
+		switch {
+		case operation.Op == toggl.PatchOpAdd && isArray && valueIsArray:
+			for _, v := range values {
+				if !slices.ContainsFunc(current, func(c any) bool { return reflect.DeepEqual(c, v) }) {
+					current = append(current, v)
+				}
+			}
+			fields[name] = current
+		case operation.Op == toggl.PatchOpRemove && isArray && valueIsArray:
+			fields[name] = slices.DeleteFunc(current, func(c any) bool {
+				return slices.ContainsFunc(values, func(v any) bool { return reflect.DeepEqual(c, v) })
+			})
+		case operation.Op == toggl.PatchOpRemove:
+			delete(fields, name)
+		case operation.Op == toggl.PatchOpAdd, operation.Op == toggl.PatchOpReplace:
+			fields[name] = value
+		default:
+			return nil, patchError("Invalid patch operation")
+		}
+	}
+
+	b, _ = json.Marshal(fields)
+	patched := new(T)
+	if err := json.Unmarshal(b, patched); err != nil {
+		return nil, patchError("Invalid patch value")
+	}
+	return patched, nil
+}
+
+// normalizeJSON converts the value into the representation of encoding/json such as []any and float64.
+func normalizeJSON(value any) any {
+	b, _ := json.Marshal(value)
+	var normalized any
+	json.Unmarshal(b, &normalized)
+	return normalized
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	var body json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
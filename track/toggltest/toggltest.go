@@ -0,0 +1,288 @@
+/*
+Package toggltest provides an in-memory fake of Toggl Track API for testing code built on toggl-go.
+
+The fake emulates the commonly used endpoints of Toggl API v9, Reports API v3, and Webhooks API v1 statefully,
+so that resources created through one endpoint are returned by the others.
+Every request received by the fake is recorded, and can be examined by the assertion helpers.
+
+	server := toggltest.NewServer()
+	defer server.Close()
+
+	workspace := server.AddWorkspace(&toggl.Workspace{Name: track.Ptr("My Workspace")})
+	apiClient := toggl.NewAPIClient(toggl.WithHTTPClient(server.Client()))
+	project, err := apiClient.CreateProject(ctx, *workspace.ID, &toggl.CreateProjectRequestBody{Name: track.Ptr("My Project")})
+	...
+	server.AssertRequested(t, http.MethodPost, fmt.Sprintf("/api/v9/workspaces/%d/projects", *workspace.ID))
+
+For integration tests against the real API, Recorder captures interactions once into a sanitized cassette,
+and Replayer serves the cassette offline afterwards.
+*/
+package toggltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+	"github.com/ta9mi141/toggl-go/track/webhooks"
+)
+
+const (
+	basicAuthPassword string = "api_token" // Defined in Toggl Track API
+
+	defaultDetailedReportPageSize int = 50
+)
+
+// Server is a fake Toggl Track API server which keeps its state in memory.
+// It is safe for concurrent use by multiple goroutines.
+type Server struct {
+	// URL is the base URL of the server, which can be used instead of https://api.track.toggl.com/.
+	URL string
+
+	server *httptest.Server
+	mux    *http.ServeMux
+
+	apiToken               string
+	now                    func() time.Time
+	detailedReportPageSize int
+
+	mu            sync.Mutex
+	lastID        int
+	me            *toggl.Me
+	workspaces    map[int]*toggl.Workspace
+	projects      map[int]*toggl.Project
+	clients       map[int]*toggl.Client
+	tags          map[int]*toggl.Tag
+	timeEntries   map[int]*toggl.TimeEntry
+	eventFilters  *webhooks.EventFilters
+	subscriptions map[int]map[string]any
+	failures      []*failure
+	requests      []*Request
+}
+
+type failure struct {
+	method     string
+	path       string
+	statusCode int
+	message    string
+}
+
+// NewServer starts a new fake server. The caller should call Close to shut down the server.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		now:                    time.Now,
+		detailedReportPageSize: defaultDetailedReportPageSize,
+		workspaces:             make(map[int]*toggl.Workspace),
+		projects:               make(map[int]*toggl.Project),
+		clients:                make(map[int]*toggl.Client),
+		tags:                   make(map[int]*toggl.Tag),
+		timeEntries:            make(map[int]*toggl.TimeEntry),
+		subscriptions:          make(map[int]map[string]any),
+	}
+	for _, option := range options {
+		option.apply(s)
+	}
+
+	s.lastID++
+	s.me = &toggl.Me{
+		ID:       track.Ptr(s.lastID),
+		Email:    track.Ptr("toggltest@example.com"),
+		Fullname: track.Ptr("Toggl Test"),
+		Timezone: track.Ptr("UTC"),
+	}
+	s.eventFilters = defaultEventFilters()
+
+	s.mux = http.NewServeMux()
+	s.registerTogglHandlers()
+	s.registerReportsHandlers()
+	s.registerWebhooksHandlers()
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Option is an option for a fake server.
+type Option interface {
+	apply(*Server)
+}
+
+// WithAPIToken returns a Option that makes the server reject requests which aren't authenticated by the API token.
+// By default, the server accepts requests regardless of their credentials.
+func WithAPIToken(apiToken string) Option {
+	return apiTokenOption(apiToken)
+}
+
+type apiTokenOption string
+
+func (a apiTokenOption) apply(s *Server) {
+	s.apiToken = string(a)
+}
+
+// WithClock returns a Option that specifies the current time of the server,
+// which is used for timestamps such as at and the duration of running time entries.
+func WithClock(now func() time.Time) Option {
+	return clockOption(now)
+}
+
+type clockOption func() time.Time
+
+func (c clockOption) apply(s *Server) {
+	s.now = c
+}
+
+// WithDetailedReportPageSize returns a Option that specifies the number of rows in a page of detailed reports.
+func WithDetailedReportPageSize(pageSize int) Option {
+	return detailedReportPageSizeOption(pageSize)
+}
+
+type detailedReportPageSizeOption int
+
+func (d detailedReportPageSizeOption) apply(s *Server) {
+	s.detailedReportPageSize = int(d)
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns an HTTP client which sends every request to the server regardless of its host,
+// so that API clients with the default base URL can be pointed at the server by WithHTTPClient.
+func (s *Server) Client() *http.Client {
+	target, _ := url.Parse(s.URL)
+	return &http.Client{Transport: &redirectTransport{target: target, base: s.server.Client().Transport}}
+}
+
+type redirectTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+func (r *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+	req.Host = r.target.Host
+	return r.base.RoundTrip(req)
+}
+
+// FailNext makes the server respond to the next request matching the method and the path with the status code.
+// The message is returned as the error message in the same format as Toggl API.
+func (s *Server) FailNext(method, path string, statusCode int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &failure{method: method, path: path, statusCode: statusCode, message: message})
+}
+
+// ServeHTTP implements http.Handler.
+// Requests are handled one by one, so handlers don't have to care about concurrency.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, &Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	if s.apiToken != "" {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != s.apiToken || password != basicAuthPassword {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, "Incorrect username and/or password")
+			return
+		}
+	}
+
+	for i, f := range s.failures {
+		if f.method == r.Method && f.path == r.URL.Path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			writeError(w, f.statusCode, f.message)
+			return
+		}
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+func (s *Server) timestamp() *time.Time {
+	return track.Ptr(s.now().UTC().Truncate(time.Second))
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error message as a JSON string, which is the most common format of errors in Toggl API.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, message)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "JSON is not valid")
+		return false
+	}
+	return true
+}
+
+// pathInt parses the path value as an integer, and writes the same error as Toggl API if it's not an integer.
+func pathInt(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	i, err := strconv.Atoi(r.PathValue(name))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("We're expecting an integer as part of the url for %s", name))
+		return 0, false
+	}
+	return i, true
+}
+
+// requireField panics with a message naming the field if a field required by a seed helper isn't set.
+func requireField(helper, field string, ok bool) {
+	if !ok {
+		panic(fmt.Sprintf("toggltest: %s: %s must be set", helper, field))
+	}
+}
+
+// clone returns a deep copy of v so that the state of the server isn't shared with callers.
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	c := new(T)
+	if err := json.Unmarshal(b, c); err != nil {
+		panic(err)
+	}
+	return c
+}
@@ -0,0 +1,362 @@
+package toggltest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+	"github.com/ta9mi141/toggl-go/track/reports"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+	"github.com/ta9mi141/toggl-go/track/webhooks"
+)
+
+var now = time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestServer(t *testing.T, options ...Option) (*Server, *toggl.APIClient, int) {
+	server := NewServer(append([]Option{WithClock(func() time.Time { return now })}, options...)...)
+	t.Cleanup(server.Close)
+	workspace := server.AddWorkspace(&toggl.Workspace{Name: track.Ptr("Workspace")})
+	apiClient := toggl.NewAPIClient(toggl.WithAPIToken(internal.APIToken), toggl.WithHTTPClient(server.Client()))
+	return server, apiClient, *workspace.ID
+}
+
+func TestProjects(t *testing.T) {
+	server, apiClient, workspaceID := newTestServer(t)
+	ctx := context.Background()
+
+	project, err := apiClient.CreateProject(ctx, workspaceID, &toggl.CreateProjectRequestBody{Name: track.Ptr("Project")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if *project.WorkspaceID != workspaceID || !*project.Active {
+		internal.Errorf(t, project, "an active project in the workspace")
+	}
+	_, err = apiClient.CreateProject(ctx, workspaceID, &toggl.CreateProjectRequestBody{Name: track.Ptr("Project")})
+	if !strings.Contains(err.Error(), "Name has already been taken") {
+		internal.Errorf(t, err, "Name has already been taken")
+	}
+
+	updated, err := apiClient.UpdateProject(ctx, workspaceID, *project.ID, &toggl.UpdateProjectRequestBody{Color: track.Ptr("#0b83d9")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if *updated.Name != "Project" || *updated.Color != "#0b83d9" {
+		internal.Errorf(t, updated, "the project with the new color")
+	}
+
+	server.AddProject(&toggl.Project{WorkspaceID: track.Ptr(workspaceID), Name: track.Ptr("Seeded"), Active: track.Ptr(false)})
+	projects, err := apiClient.GetProjects(ctx, workspaceID, &toggl.GetProjectsQuery{Active: track.Ptr(false)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(projects) != 1 || *projects[0].Name != "Seeded" {
+		internal.Errorf(t, projects, "the inactive seeded project")
+	}
+	server.AddProject(&toggl.Project{WorkspaceID: track.Ptr(workspaceID), Active: track.Ptr(true)})
+	projects, err = apiClient.GetProjects(ctx, workspaceID, &toggl.GetProjectsQuery{Name: track.Ptr("proj")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(projects) != 1 || *projects[0].ID != *project.ID {
+		internal.Errorf(t, projects, "only the project whose name matches")
+	}
+
+	if err := apiClient.DeleteProject(ctx, workspaceID, *project.ID); err != nil {
+		t.Fatal(err.Error())
+	}
+	_, err = apiClient.GetProject(ctx, workspaceID, *project.ID, nil)
+	if !track.IsNotFound(err) {
+		internal.Errorf(t, err, "404 Not Found")
+	}
+
+	server.AssertRequestBody(t, http.MethodPut, fmt.Sprintf("/api/v9/workspaces/%d/projects/%d", workspaceID, *project.ID), `{"color":"#0b83d9"}`)
+	server.AssertRequestCount(t, http.MethodPost, fmt.Sprintf("/api/v9/workspaces/%d/projects", workspaceID), 2)
+}
+
+func TestClients(t *testing.T) {
+	server, apiClient, workspaceID := newTestServer(t)
+	ctx := context.Background()
+
+	client := server.AddClient(&toggl.Client{WID: track.Ptr(workspaceID), Name: track.Ptr("Acme")})
+	server.AddClient(&toggl.Client{WID: track.Ptr(workspaceID), Name: track.Ptr("Globex")})
+	project := server.AddProject(&toggl.Project{WorkspaceID: track.Ptr(workspaceID), ClientID: client.ID, Name: track.Ptr("Project")})
+
+	projectIDs, err := apiClient.ArchiveClient(ctx, workspaceID, *client.ID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(projectIDs, []int{*project.ID}) {
+		internal.Errorf(t, projectIDs, []int{*project.ID})
+	}
+
+	clients, err := apiClient.GetClients(ctx, workspaceID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(clients) != 1 || *clients[0].Name != "Globex" {
+		internal.Errorf(t, clients, "the active client")
+	}
+	clients, err = apiClient.GetClientsWithQuery(ctx, workspaceID, &toggl.GetClientsQuery{Status: track.Ptr(toggl.ClientStatusBoth), Name: track.Ptr("acm")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(clients) != 1 || *clients[0].ID != *client.ID {
+		internal.Errorf(t, clients, "the archived client named Acme")
+	}
+	server.AddClient(&toggl.Client{WID: track.Ptr(workspaceID)})
+	clients, err = apiClient.GetClientsWithQuery(ctx, workspaceID, &toggl.GetClientsQuery{Name: track.Ptr("glob")})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(clients) != 1 || *clients[0].Name != "Globex" {
+		internal.Errorf(t, clients, "only the client whose name matches")
+	}
+
+	restored, err := apiClient.RestoreClient(ctx, workspaceID, *client.ID, &toggl.RestoreClientRequestBody{RestoreAllProjects: track.Ptr(true)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if *restored.Archived || !*server.Projects()[0].Active {
+		internal.Errorf(t, []any{restored, server.Projects()[0]}, "the restored client and project")
+	}
+}
+
+func TestTimeEntries(t *testing.T) {
+	server, apiClient, workspaceID := newTestServer(t)
+	ctx := context.Background()
+
+	first, err := apiClient.StartTimeEntry(ctx, workspaceID, &toggl.CreateTimeEntryRequestBody{
+		Description: track.Ptr("first"),
+		Start:       track.Ptr(now.Add(-time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	second, err := apiClient.StartTimeEntry(ctx, workspaceID, &toggl.CreateTimeEntryRequestBody{Description: track.Ptr("second"), Start: track.Ptr(now)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Starting a time entry stops the running one.
+	stopped := server.TimeEntries()[0]
+	if stopped.IsRunning() || *stopped.Duration != 3600 {
+		internal.Errorf(t, stopped, "the first time entry stopped after an hour")
+	}
+	current, err := apiClient.GetCurrentTimeEntry(ctx)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if *current.ID != *second.ID {
+		internal.Errorf(t, *current.ID, *second.ID)
+	}
+
+	if _, err := apiClient.StopCurrentTimeEntry(ctx); err != nil {
+		t.Fatal(err.Error())
+	}
+	current, err = apiClient.StopCurrentTimeEntry(ctx)
+	if err != nil || current != nil {
+		internal.Errorf(t, []any{current, err}, []any{nil, nil})
+	}
+
+	tag := server.AddTag(&toggl.Tag{WorkspaceID: track.Ptr(workspaceID), Name: track.Ptr("tag")})
+	patch := toggl.NewTimeEntryPatch().Billable(true).AddTagIDs([]int{*tag.ID})
+	result, err := apiClient.PatchTimeEntries(ctx, workspaceID, []int{*first.ID, *second.ID, 0}, patch.Operations())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(result.Success) != 2 || len(result.Failure) != 1 || *result.Failure[0].ID != 0 {
+		internal.Errorf(t, result, "2 successes and a failure of ID 0")
+	}
+	for _, te := range server.TimeEntries() {
+		if !*te.Billable || !reflect.DeepEqual(te.TagIDs, []*int{tag.ID}) {
+			internal.Errorf(t, te, "a billable time entry with the tag")
+		}
+	}
+
+	timeEntries, err := apiClient.GetTimeEntries(ctx, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(timeEntries) != 2 || *timeEntries[0].ID != *second.ID {
+		internal.Errorf(t, timeEntries, "the time entries from the latest")
+	}
+	timeEntries, err = apiClient.GetTimeEntries(ctx, &toggl.GetTimeEntriesQuery{Before: track.Ptr(now.Format(time.RFC3339))})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(timeEntries) != 1 || *timeEntries[0].ID != *first.ID {
+		internal.Errorf(t, timeEntries, "only the time entry started before now")
+	}
+}
+
+func TestDetailedReport(t *testing.T) {
+	server, _, workspaceID := newTestServer(t, WithDetailedReportPageSize(2))
+	for i := 0; i < 5; i++ {
+		server.AddTimeEntry(&toggl.TimeEntry{
+			WorkspaceID: track.Ptr(workspaceID),
+			Start:       track.Ptr(now.Add(time.Duration(i) * time.Hour)),
+			Duration:    track.Ptr(600),
+		})
+	}
+	server.AddTimeEntry(&toggl.TimeEntry{WorkspaceID: track.Ptr(workspaceID), Start: track.Ptr(now.AddDate(0, 0, 1)), Duration: track.Ptr(600)})
+
+	apiClient := reports.NewAPIClient(internal.APIToken, reports.WithHTTPClient(server.Client()))
+	detailedReport, err := apiClient.SearchAllDetailedReport(context.Background(), workspaceID, &reports.SearchDetailedReportRequestBody{
+		StartDate: track.Ptr("2020-01-02"),
+		EndDate:   track.Ptr("2020-01-02"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var rowNumbers []int
+	for _, row := range *detailedReport {
+		rowNumbers = append(rowNumbers, *row.RowNumber)
+	}
+	if !reflect.DeepEqual(rowNumbers, []int{1, 2, 3, 4, 5}) {
+		internal.Errorf(t, rowNumbers, []int{1, 2, 3, 4, 5})
+	}
+	server.AssertRequestCount(t, http.MethodPost, fmt.Sprintf("/reports/api/v3/workspace/%d/search/time_entries", workspaceID), 3)
+}
+
+func TestEventFilters(t *testing.T) {
+	server, _, _ := newTestServer(t)
+	want := &webhooks.EventFilters{TimeEntry: []*string{track.Ptr("created")}}
+	server.SetEventFilters(want)
+
+	apiClient := webhooks.NewAPIClient(internal.APIToken, webhooks.WithHTTPClient(server.Client()))
+	eventFilters, err := apiClient.GetEventFilters(context.Background())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(eventFilters, want) {
+		internal.Errorf(t, eventFilters, want)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	server, _, workspaceID := newTestServer(t)
+	apiClient := webhooks.NewAPIClient(internal.APIToken, webhooks.WithHTTPClient(server.Client()))
+	ctx := context.Background()
+
+	subscription, err := apiClient.CreateSubscription(ctx, workspaceID, &webhooks.CreateSubscriptionRequestBody{
+		Description:  track.Ptr("Subscription"),
+		EventFilters: []*webhooks.EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}},
+		URLCallback:  track.Ptr("https://example.com/webhooks"),
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if *subscription.WorkspaceID != workspaceID || *subscription.Enabled || subscription.ValidatedAt != nil {
+		internal.Errorf(t, subscription, "a disabled and unvalidated subscription in the workspace")
+	}
+	subscriptionID := *subscription.SubscriptionID
+
+	if err := apiClient.ValidateURL(ctx, workspaceID, subscriptionID, "validation_code"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := apiClient.Ping(ctx, workspaceID, subscriptionID); err != nil {
+		t.Fatal(err.Error())
+	}
+	patched, err := apiClient.PatchSubscription(ctx, workspaceID, subscriptionID, &webhooks.PatchSubscriptionRequestBody{Enabled: track.Ptr(true)})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !*patched.Enabled || !patched.ValidatedAt.Equal(now) || *patched.Description != "Subscription" {
+		internal.Errorf(t, patched, "an enabled and validated subscription")
+	}
+
+	subscriptions, err := apiClient.ListSubscriptions(ctx, workspaceID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(subscriptions) != 1 || !reflect.DeepEqual(subscriptions[0], patched) {
+		internal.Errorf(t, subscriptions, []*webhooks.Subscription{patched})
+	}
+
+	if err := apiClient.DeleteSubscription(ctx, workspaceID, subscriptionID); err != nil {
+		t.Fatal(err.Error())
+	}
+	err = apiClient.Ping(ctx, workspaceID, subscriptionID)
+	if !track.IsNotFound(err) {
+		internal.Errorf(t, err, "404 Not Found")
+	}
+}
+
+func TestAddRequiredFields(t *testing.T) {
+	tests := []struct {
+		name string
+		in   func(server *Server)
+		out  string
+	}{
+		{
+			name: "project without workspace",
+			in:   func(server *Server) { server.AddProject(&toggl.Project{Name: track.Ptr("Project")}) },
+			out:  "toggltest: AddProject: WorkspaceID must be set",
+		},
+		{
+			name: "client without workspace",
+			in:   func(server *Server) { server.AddClient(&toggl.Client{Name: track.Ptr("Client")}) },
+			out:  "toggltest: AddClient: WID must be set",
+		},
+		{
+			name: "tag without name",
+			in:   func(server *Server) { server.AddTag(&toggl.Tag{WorkspaceID: track.Ptr(1234567)}) },
+			out:  "toggltest: AddTag: Name must be set",
+		},
+		{
+			name: "time entry without workspace",
+			in:   func(server *Server) { server.AddTimeEntry(&toggl.TimeEntry{Duration: track.Ptr(600)}) },
+			out:  "toggltest: AddTimeEntry: WorkspaceID must be set",
+		},
+		{
+			name: "nil workspace",
+			in:   func(server *Server) { server.AddWorkspace(nil) },
+			out:  "toggltest: AddWorkspace: workspace must be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, _ := newTestServer(t)
+			defer func() {
+				if r := recover(); r != tt.out {
+					internal.Errorf(t, r, tt.out)
+				}
+			}()
+			tt.in(server)
+		})
+	}
+}
+
+func TestWithAPIToken(t *testing.T) {
+	server, apiClient, _ := newTestServer(t, WithAPIToken("another_api_token"))
+
+	_, err := apiClient.GetMe(context.Background())
+	if !track.IsForbidden(err) {
+		internal.Errorf(t, err, "403 Forbidden")
+	}
+	server.AssertRequested(t, http.MethodGet, "/api/v9/me")
+}
+
+func TestFailNext(t *testing.T) {
+	server, apiClient, _ := newTestServer(t)
+	server.FailNext(http.MethodGet, "/api/v9/me", http.StatusTooManyRequests, "Too many requests")
+
+	_, err := apiClient.GetMe(context.Background())
+	if isTemporary, _ := track.IsTemporary(err); !isTemporary {
+		internal.Errorf(t, err, "429 Too Many Requests")
+	}
+	me, err := apiClient.GetMe(context.Background())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(me, server.Me()) {
+		internal.Errorf(t, me, server.Me())
+	}
+}
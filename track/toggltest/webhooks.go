@@ -0,0 +1,177 @@
+package toggltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/webhooks"
+)
+
+// SetEventFilters replaces the event filters supported by the server.
+func (s *Server) SetEventFilters(eventFilters *webhooks.EventFilters) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventFilters = clone(eventFilters)
+}
+
+func defaultEventFilters() *webhooks.EventFilters {
+	actions := func(actions ...string) []*string {
+		result := make([]*string, 0, len(actions))
+		for _, action := range actions {
+			result = append(result, track.Ptr(action))
+		}
+		return result
+	}
+	return &webhooks.EventFilters{
+		Client:        actions("*", "created", "deleted", "updated"),
+		Project:       actions("*", "created", "deleted", "updated"),
+		ProjectGroup:  actions("*", "created", "deleted", "updated"),
+		ProjectUser:   actions("*", "created", "deleted", "updated"),
+		Tag:           actions("*", "created", "deleted", "updated"),
+		Task:          actions("*", "created", "deleted", "updated"),
+		TimeEntry:     actions("*", "created", "deleted", "updated"),
+		Workspace:     actions("*", "created", "deleted", "updated"),
+		WorkspaceUser: actions("*", "created", "deleted", "updated"),
+	}
+}
+
+func (s *Server) registerWebhooksHandlers() {
+	s.mux.HandleFunc("GET /webhooks/api/v1/event_filters", s.getEventFilters)
+	s.mux.HandleFunc("GET /webhooks/api/v1/subscriptions/{workspace_id}", s.listSubscriptions)
+	s.mux.HandleFunc("POST /webhooks/api/v1/subscriptions/{workspace_id}", s.createSubscription)
+	s.mux.HandleFunc("PUT /webhooks/api/v1/subscriptions/{workspace_id}/{subscription_id}", s.updateSubscription)
+	s.mux.HandleFunc("PATCH /webhooks/api/v1/subscriptions/{workspace_id}/{subscription_id}", s.updateSubscription)
+	s.mux.HandleFunc("DELETE /webhooks/api/v1/subscriptions/{workspace_id}/{subscription_id}", s.deleteSubscription)
+	s.mux.HandleFunc("POST /webhooks/api/v1/ping/{workspace_id}/{subscription_id}", s.ping)
+	s.mux.HandleFunc("GET /webhooks/api/v1/validate/{workspace_id}/{subscription_id}/{validation_code}", s.validateURL)
+}
+
+func (s *Server) getEventFilters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.eventFilters)
+}
+
+// Subscriptions are kept as JSON objects so that the server doesn't depend on their schema.
+func (s *Server) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	ids := []int{}
+	for id, subscription := range s.subscriptions {
+		if subscription["workspace_id"] == float64(workspaceID) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	subscriptions := make([]map[string]any, 0, len(ids))
+	for _, id := range ids {
+		subscriptions = append(subscriptions, s.subscriptions[id])
+	}
+	writeJSON(w, http.StatusOK, subscriptions)
+}
+
+func (s *Server) subscription(w http.ResponseWriter, r *http.Request, workspaceID int) (map[string]any, bool) {
+	subscriptionID, ok := pathInt(w, r, "subscription_id")
+	if !ok {
+		return nil, false
+	}
+	subscription, ok := s.subscriptions[subscriptionID]
+	if !ok || subscription["workspace_id"] != float64(workspaceID) {
+		writeError(w, http.StatusNotFound, "Subscription not found")
+		return nil, false
+	}
+	return subscription, true
+}
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	subscription := map[string]any{}
+	if !decodeBody(w, r, &subscription) {
+		return
+	}
+	if callback, _ := subscription["url_callback"].(string); callback == "" {
+		writeError(w, http.StatusBadRequest, "URL callback must be present")
+		return
+	}
+	subscriptionID := s.nextID()
+	now := s.timestamp()
+	subscription["subscription_id"] = float64(subscriptionID)
+	subscription["workspace_id"] = float64(workspaceID)
+	subscription["user_id"] = float64(*s.me.ID)
+	subscription["created_at"] = now
+	subscription["updated_at"] = now
+	if _, ok := subscription["enabled"]; !ok {
+		subscription["enabled"] = false
+	}
+	s.subscriptions[subscriptionID] = normalizeSubscription(subscription)
+	writeJSON(w, http.StatusOK, s.subscriptions[subscriptionID])
+}
+
+func (s *Server) updateSubscription(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	old, ok := s.subscription(w, r, workspaceID)
+	if !ok {
+		return
+	}
+	subscription := normalizeSubscription(old)
+	if !decodeBody(w, r, &subscription) {
+		return
+	}
+	for _, key := range []string{"subscription_id", "workspace_id", "user_id", "created_at"} {
+		subscription[key] = old[key]
+	}
+	subscription["updated_at"] = s.timestamp()
+	subscription = normalizeSubscription(subscription)
+	s.subscriptions[int(subscription["subscription_id"].(float64))] = subscription
+	writeJSON(w, http.StatusOK, subscription)
+}
+
+func (s *Server) deleteSubscription(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if subscription, ok := s.subscription(w, r, workspaceID); ok {
+		delete(s.subscriptions, int(subscription["subscription_id"].(float64)))
+		writeJSON(w, http.StatusOK, subscription)
+	}
+}
+
+// ping only checks that the subscription exists, because the server doesn't deliver events.
+func (s *Server) ping(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := s.subscription(w, r, workspaceID); ok {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// validateURL accepts any validation code, because the server doesn't send one to the URL callback.
+func (s *Server) validateURL(w http.ResponseWriter, r *http.Request) {
+	workspaceID, ok := s.workspaceID(w, r)
+	if !ok {
+		return
+	}
+	if subscription, ok := s.subscription(w, r, workspaceID); ok {
+		subscription["validated_at"] = s.timestamp()
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// normalizeSubscription returns a copy of the subscription in the representation of encoding/json.
+func normalizeSubscription(subscription map[string]any) map[string]any {
+	b, _ := json.Marshal(subscription)
+	normalized := map[string]any{}
+	json.Unmarshal(b, &normalized)
+	return normalized
+}
@@ -1,6 +1,67 @@
 package track
 
+import (
+	"encoding/json"
+)
+
 // Ptr returns a pointer to the given value.
 func Ptr[T any](v T) *T {
 	return &v
 }
+
+// Nullable represents a field of a request body which can be unset, explicitly null, or set to a value.
+// The zero value is unset, which is omitted from JSON by omitempty like a nil pointer,
+// while a null Nullable is encoded as null so that the field is cleared.
+//
+// Nullable is implemented as a map so that omitempty works on it: the key is true if a value is set.
+type Nullable[T any] map[bool]T
+
+// NewNullable returns a Nullable set to the given value.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{true: v}
+}
+
+// Null returns a Nullable which is explicitly null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{false: *new(T)}
+}
+
+// IsSpecified reports whether n is either null or set to a value.
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// IsNull reports whether n is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+	return ok
+}
+
+// Get returns the value of n, and whether it's set to a value.
+func (n Nullable[T]) Get() (T, bool) {
+	v, ok := n[true]
+	return v, ok
+}
+
+// MarshalJSON implements json.Marshaler.
+// An unset Nullable is also encoded as null if the field doesn't have omitempty.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if v, ok := n.Get(); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
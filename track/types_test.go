@@ -0,0 +1,109 @@
+package track
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNullableMarshalJSON(t *testing.T) {
+	type requestBody struct {
+		ProjectID Nullable[int]    `json:"project_id,omitempty"`
+		Notes     Nullable[string] `json:"notes,omitempty"`
+	}
+	tests := []struct {
+		name string
+		in   *requestBody
+		out  string
+	}{
+		{
+			name: "unset",
+			in:   &requestBody{},
+			out:  `{}`,
+		},
+		{
+			name: "null",
+			in:   &requestBody{ProjectID: Null[int]()},
+			out:  `{"project_id":null}`,
+		},
+		{
+			name: "value",
+			in:   &requestBody{ProjectID: NewNullable(0), Notes: NewNullable("notes")},
+			out:  `{"project_id":0,"notes":"notes"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if string(b) != tt.out {
+				t.Errorf("\nwant: %s\ngot : %s\n", tt.out, string(b))
+			}
+		})
+	}
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	type responseBody struct {
+		ProjectID Nullable[int] `json:"project_id"`
+	}
+	tests := []struct {
+		name string
+		in   string
+		out  Nullable[int]
+	}{
+		{
+			name: "missing",
+			in:   `{}`,
+			out:  nil,
+		},
+		{
+			name: "null",
+			in:   `{"project_id":null}`,
+			out:  Null[int](),
+		},
+		{
+			name: "value",
+			in:   `{"project_id":1234567}`,
+			out:  NewNullable(1234567),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got responseBody
+			if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+				t.Fatal(err.Error())
+			}
+			if !reflect.DeepEqual(got.ProjectID, tt.out) {
+				t.Errorf("\nwant: %+#v\ngot : %+#v\n", tt.out, got.ProjectID)
+			}
+		})
+	}
+}
+
+func TestNullableState(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          Nullable[string]
+		isSpecified bool
+		isNull      bool
+		value       string
+		ok          bool
+	}{
+		{name: "unset", in: Nullable[string]{}, isSpecified: false, isNull: false, value: "", ok: false},
+		{name: "null", in: Null[string](), isSpecified: true, isNull: true, value: "", ok: false},
+		{name: "value", in: NewNullable(""), isSpecified: true, isNull: false, value: "", ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := tt.in.Get()
+			got := []any{tt.in.IsSpecified(), tt.in.IsNull(), value, ok}
+			want := []any{tt.isSpecified, tt.isNull, tt.value, tt.ok}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("\nwant: %+#v\ngot : %+#v\n", want, got)
+			}
+		})
+	}
+}
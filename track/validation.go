@@ -0,0 +1,40 @@
+package track
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ValidationError is returned when a request body or a query is found invalid before it's sent to Toggl API.
+// It reports every invalid field at once.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+// FieldError represents the reason why a field is invalid.
+// Field is the name in JSON or the query string, such as created_with.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements error.
+func (f *FieldError) Error() string {
+	return f.Field + ": " + f.Message
+}
+
+// Error implements error.
+func (v *ValidationError) Error() string {
+	messages := make([]string, 0, len(v.Fields))
+	for _, field := range v.Fields {
+		messages = append(messages, field.Error())
+	}
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+// IsValidationError reports whether err was caused by a ValidationError.
+func IsValidationError(err error) bool {
+	validationError := new(ValidationError)
+	return errors.As(err, &validationError)
+}
@@ -0,0 +1,27 @@
+package track
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestValidationError(t *testing.T) {
+	validationError := &ValidationError{
+		Fields: []*FieldError{
+			{Field: "created_with", Message: "is required"},
+			{Field: "stop", Message: "must not be before start"},
+		},
+	}
+
+	want := "invalid request: created_with: is required; stop: must not be before start"
+	if validationError.Error() != want {
+		t.Errorf("\nwant: %+#v\ngot : %+#v\n", want, validationError.Error())
+	}
+	if !IsValidationError(errors.Wrap(validationError, "failed to create time entry")) {
+		t.Error("wrapped ValidationError is not detected")
+	}
+	if IsValidationError(errors.New("failed to create time entry")) {
+		t.Error("other error is detected as ValidationError")
+	}
+}
@@ -0,0 +1,133 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestAPIClientConcurrentUse(t *testing.T) {
+	const (
+		parallelism = 10
+
+		workspaceID    = 2345678
+		subscriptionID = 5678901
+	)
+	endpoints := []struct {
+		name   string
+		method string
+		path   string
+		call   func(ctx context.Context, c *APIClient) error
+	}{
+		{
+			name:   "GetEventFilters",
+			method: http.MethodGet,
+			path:   "/webhooks/api/v1/event_filters",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetEventFilters(ctx)
+				return err
+			},
+		},
+		{
+			name:   "ListSubscriptions",
+			method: http.MethodGet,
+			path:   "/webhooks/api/v1/subscriptions/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.ListSubscriptions(ctx, workspaceID)
+				return err
+			},
+		},
+		{
+			name:   "CreateSubscription",
+			method: http.MethodPost,
+			path:   "/webhooks/api/v1/subscriptions/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.CreateSubscription(ctx, workspaceID, &CreateSubscriptionRequestBody{URLCallback: track.Ptr("https://example.com/webhooks"), EventFilters: []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("*")}}})
+				return err
+			},
+		},
+		{
+			name:   "UpdateSubscription",
+			method: http.MethodPut,
+			path:   "/webhooks/api/v1/subscriptions/2345678/5678901",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.UpdateSubscription(ctx, workspaceID, subscriptionID, &UpdateSubscriptionRequestBody{URLCallback: track.Ptr("https://example.com/webhooks"), EventFilters: []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("*")}}})
+				return err
+			},
+		},
+		{
+			name:   "PatchSubscription",
+			method: http.MethodPatch,
+			path:   "/webhooks/api/v1/subscriptions/2345678/5678901",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.PatchSubscription(ctx, workspaceID, subscriptionID, &PatchSubscriptionRequestBody{Enabled: track.Ptr(true)})
+				return err
+			},
+		},
+		{
+			name:   "DeleteSubscription",
+			method: http.MethodDelete,
+			path:   "/webhooks/api/v1/subscriptions/2345678/5678901",
+			call: func(ctx context.Context, c *APIClient) error {
+				err := c.DeleteSubscription(ctx, workspaceID, subscriptionID)
+				return err
+			},
+		},
+		{
+			name:   "Ping",
+			method: http.MethodPost,
+			path:   "/webhooks/api/v1/ping/2345678/5678901",
+			call: func(ctx context.Context, c *APIClient) error {
+				err := c.Ping(ctx, workspaceID, subscriptionID)
+				return err
+			},
+		},
+		{
+			name:   "ValidateURL",
+			method: http.MethodGet,
+			path:   "/webhooks/api/v1/validate/2345678/5678901/6f1b2a3c",
+			call: func(ctx context.Context, c *APIClient) error {
+				err := c.ValidateURL(ctx, workspaceID, subscriptionID, "6f1b2a3c")
+				return err
+			},
+		},
+		{
+			name:   "GetSubscriptionLimits",
+			method: http.MethodGet,
+			path:   "/webhooks/api/v1/limits/2345678",
+			call: func(ctx context.Context, c *APIClient) error {
+				_, err := c.GetSubscriptionLimits(ctx, workspaceID)
+				return err
+			},
+		},
+	}
+
+	mockServer, counts := internal.NewMockServerToCountRequests(t)
+	defer mockServer.Close()
+	apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+
+	want := make(map[string]int)
+	var wg sync.WaitGroup
+	for _, endpoint := range endpoints {
+		want[endpoint.method+" "+endpoint.path] += parallelism
+		for i := 0; i < parallelism; i++ {
+			wg.Add(1)
+			go func(name string, call func(ctx context.Context, c *APIClient) error) {
+				defer wg.Done()
+				if err := call(context.Background(), apiClient); err != nil {
+					t.Errorf("%s: %v", name, err)
+				}
+			}(endpoint.name, endpoint.call)
+		}
+	}
+	wg.Wait()
+
+	if got := counts(); !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+}
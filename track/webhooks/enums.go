@@ -0,0 +1,46 @@
+package webhooks
+
+// Entity specifies the kind of resource which an event is about.
+type Entity string
+
+const (
+	EntityClient        Entity = "client"
+	EntityProject       Entity = "project"
+	EntityProjectGroup  Entity = "project_group"
+	EntityProjectUser   Entity = "project_user"
+	EntityTag           Entity = "tag"
+	EntityTask          Entity = "task"
+	EntityTimeEntry     Entity = "time_entry"
+	EntityWorkspace     Entity = "workspace"
+	EntityWorkspaceUser Entity = "workspace_user"
+	EntityAll           Entity = "*"
+)
+
+// IsValid reports whether e is one of the defined entities.
+func (e Entity) IsValid() bool {
+	switch e {
+	case EntityClient, EntityProject, EntityProjectGroup, EntityProjectUser, EntityTag,
+		EntityTask, EntityTimeEntry, EntityWorkspace, EntityWorkspaceUser, EntityAll:
+		return true
+	}
+	return false
+}
+
+// Action specifies what happened to the resource of an event.
+type Action string
+
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionDeleted Action = "deleted"
+	ActionAll     Action = "*"
+)
+
+// IsValid reports whether a is one of the defined actions.
+func (a Action) IsValid() bool {
+	switch a {
+	case ActionCreated, ActionUpdated, ActionDeleted, ActionAll:
+		return true
+	}
+	return false
+}
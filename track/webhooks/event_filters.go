@@ -2,9 +2,12 @@ package webhooks
 
 import (
 	"context"
+	"fmt"
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
 // EventFilters represents the properties of event filters.
@@ -29,3 +32,116 @@ func (c *APIClient) GetEventFilters(ctx context.Context) (*EventFilters, error)
 	}
 	return eventFilters, nil
 }
+
+// Supports reports whether the event filter of the entity and the action is one of the event filters.
+// EntityAll is supported if any entity supports the action.
+func (e *EventFilters) Supports(entity Entity, action Action) bool {
+	if entity == EntityAll {
+		for _, entity := range []Entity{
+			EntityClient, EntityProject, EntityProjectGroup, EntityProjectUser, EntityTag,
+			EntityTask, EntityTimeEntry, EntityWorkspace, EntityWorkspaceUser,
+		} {
+			if e.Supports(entity, action) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, supported := range e.actions(entity) {
+		if supported != nil && Action(*supported) == action {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *EventFilters) actions(entity Entity) []*string {
+	switch entity {
+	case EntityClient:
+		return e.Client
+	case EntityProject:
+		return e.Project
+	case EntityProjectGroup:
+		return e.ProjectGroup
+	case EntityProjectUser:
+		return e.ProjectUser
+	case EntityTag:
+		return e.Tag
+	case EntityTask:
+		return e.Task
+	case EntityTimeEntry:
+		return e.TimeEntry
+	case EntityWorkspace:
+		return e.Workspace
+	case EntityWorkspaceUser:
+		return e.WorkspaceUser
+	}
+	return nil
+}
+
+// EventFilterBuilder builds the event filters of CreateSubscription and UpdateSubscription.
+type EventFilterBuilder struct {
+	supported    *EventFilters
+	eventFilters []*typedEventFilter
+}
+
+// typedEventFilter is an EventFilter whose entity and action are validated as enums.
+type typedEventFilter struct {
+	Entity Entity `json:"entity"`
+	Action Action `json:"action"`
+}
+
+// NewEventFilterBuilder creates an empty EventFilterBuilder which validates event filters against the supported ones.
+// The supported event filters are usually the ones returned by GetEventFilters.
+// If they are nil, only whether entities and actions are defined is validated.
+func NewEventFilterBuilder(supported *EventFilters) *EventFilterBuilder {
+	return &EventFilterBuilder{supported: supported}
+}
+
+// Add adds the event filter of the entity and the action.
+func (b *EventFilterBuilder) Add(entity Entity, action Action) *EventFilterBuilder {
+	b.eventFilters = append(b.eventFilters, &typedEventFilter{Entity: entity, Action: action})
+	return b
+}
+
+// AddAll adds the event filters of the entity and each of the actions.
+func (b *EventFilterBuilder) AddAll(entity Entity, actions ...Action) *EventFilterBuilder {
+	for _, action := range actions {
+		b.Add(entity, action)
+	}
+	return b
+}
+
+// Build returns the event filters built so far, or a *track.ValidationError if any of them is invalid or not supported.
+func (b *EventFilterBuilder) Build() ([]*EventFilter, error) {
+	// The event filters are wrapped so that the invalid fields are named as in the request body.
+	v := internal.NewValidator(struct {
+		EventFilters []*typedEventFilter `json:"event_filters"`
+	}{
+		EventFilters: b.eventFilters,
+	})
+	v.Check(len(b.eventFilters) > 0, "event_filters", "is required")
+	if b.supported != nil {
+		for i, eventFilter := range b.eventFilters {
+			if !eventFilter.Entity.IsValid() || !eventFilter.Action.IsValid() {
+				continue
+			}
+			v.Check(
+				b.supported.Supports(eventFilter.Entity, eventFilter.Action),
+				fmt.Sprintf("event_filters[%d]", i),
+				fmt.Sprintf("%s %s is not supported", eventFilter.Entity, eventFilter.Action),
+			)
+		}
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	eventFilters := make([]*EventFilter, 0, len(b.eventFilters))
+	for _, eventFilter := range b.eventFilters {
+		eventFilters = append(eventFilters, &EventFilter{
+			Entity: track.Ptr(string(eventFilter.Entity)),
+			Action: track.Ptr(string(eventFilter.Action)),
+		})
+	}
+	return eventFilters, nil
+}
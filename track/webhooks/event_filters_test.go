@@ -65,10 +65,10 @@ func TestGetEventFilters(t *testing.T) {
 				internal.Errorf(t, eventFilters, tt.out.eventFilters)
 			}
 
-			errorResp := new(internal.ErrorResponse)
-			if errors.As(err, &errorResp) {
-				if !reflect.DeepEqual(errorResp, tt.out.err) {
-					internal.Errorf(t, errorResp, tt.out.err)
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
 				}
 			} else {
 				if !reflect.DeepEqual(err, tt.out.err) {
@@ -78,3 +78,102 @@ func TestGetEventFilters(t *testing.T) {
 		})
 	}
 }
+
+func TestEventFiltersSupports(t *testing.T) {
+	eventFilters := &EventFilters{
+		Project:   []*string{track.Ptr("created")},
+		TimeEntry: []*string{track.Ptr("*"), track.Ptr("created"), track.Ptr("updated")},
+	}
+	tests := []struct {
+		name   string
+		entity Entity
+		action Action
+		out    bool
+	}{
+		{name: "supported", entity: EntityTimeEntry, action: ActionUpdated, out: true},
+		{name: "any action", entity: EntityTimeEntry, action: ActionAll, out: true},
+		{name: "unsupported action", entity: EntityTimeEntry, action: ActionDeleted, out: false},
+		{name: "unsupported entity", entity: EntityTag, action: ActionCreated, out: false},
+		{name: "any entity", entity: EntityAll, action: ActionCreated, out: true},
+		{name: "any entity with unsupported action", entity: EntityAll, action: ActionDeleted, out: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := eventFilters.Supports(tt.entity, tt.action); got != tt.out {
+				internal.Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
+
+func TestEventFilterBuilder(t *testing.T) {
+	supported := &EventFilters{
+		Project:   []*string{track.Ptr("created")},
+		TimeEntry: []*string{track.Ptr("*"), track.Ptr("created"), track.Ptr("updated")},
+	}
+	tests := []struct {
+		name string
+		in   *EventFilterBuilder
+		out  struct {
+			eventFilters []*EventFilter
+			err          string
+		}
+	}{
+		{
+			name: "supported",
+			in:   NewEventFilterBuilder(supported).AddAll(EntityTimeEntry, ActionCreated, ActionUpdated).Add(EntityProject, ActionCreated),
+			out: struct {
+				eventFilters []*EventFilter
+				err          string
+			}{
+				eventFilters: []*EventFilter{
+					{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")},
+					{Entity: track.Ptr("time_entry"), Action: track.Ptr("updated")},
+					{Entity: track.Ptr("project"), Action: track.Ptr("created")},
+				},
+			},
+		},
+		{
+			name: "without supported event filters",
+			in:   NewEventFilterBuilder(nil).Add(EntityTag, ActionDeleted),
+			out: struct {
+				eventFilters []*EventFilter
+				err          string
+			}{
+				eventFilters: []*EventFilter{{Entity: track.Ptr("tag"), Action: track.Ptr("deleted")}},
+			},
+		},
+		{
+			name: "empty",
+			in:   NewEventFilterBuilder(supported),
+			out: struct {
+				eventFilters []*EventFilter
+				err          string
+			}{
+				err: "invalid request: event_filters: is required",
+			},
+		},
+		{
+			name: "unsupported and undefined",
+			in:   NewEventFilterBuilder(supported).Add(EntityProject, ActionDeleted).Add(Entity("user"), ActionCreated),
+			out: struct {
+				eventFilters []*EventFilter
+				err          string
+			}{
+				err: "invalid request: event_filters[1].entity: \"user\" is not a valid value; event_filters[0]: project deleted is not supported",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventFilters, err := tt.in.Build()
+
+			if !reflect.DeepEqual(eventFilters, tt.out.eventFilters) {
+				internal.Errorf(t, eventFilters, tt.out.eventFilters)
+			}
+			if (err == nil && tt.out.err != "") || (err != nil && err.Error() != tt.out.err) {
+				internal.Errorf(t, err, tt.out.err)
+			}
+		})
+	}
+}
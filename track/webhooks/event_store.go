@@ -0,0 +1,166 @@
+package webhooks
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EventStore stores the events received by Handler so that they can be deduplicated and replayed.
+// Its methods must be safe for concurrent use by multiple goroutines.
+type EventStore interface {
+	// Save stores the event durably before it returns.
+	// It reports false without storing the event if an event of the same ID has already been stored.
+	Save(ctx context.Context, event *Event) (bool, error)
+	// MarkProcessed records durably that the stored event of the ID has been handled.
+	MarkProcessed(ctx context.Context, eventID int) error
+	// IsProcessed reports whether the event of the ID has been marked as processed.
+	IsProcessed(ctx context.Context, eventID int) (bool, error)
+	// Events returns the stored events whose time is in [from, to), ordered by time and ID.
+	// The time of an event is its timestamp, or its creation time if it has no timestamp.
+	Events(ctx context.Context, from, to time.Time) ([]*Event, error)
+}
+
+// Replay calls f for each of the events in the store whose time is in [from, to) in order.
+// It stops at the first error returned by f, so that replaying can be resumed from the event.
+func Replay(ctx context.Context, store EventStore, from, to time.Time, f func(ctx context.Context, event *Event) error) error {
+	events, err := store.Events(ctx, from, to)
+	if err != nil {
+		return errors.Wrap(err, "failed to load events")
+	}
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := f(ctx, event); err != nil {
+			return errors.Wrapf(err, "failed to replay event %d", *event.EventID)
+		}
+	}
+	return nil
+}
+
+// MemoryEventStore is an EventStore which keeps events in memory.
+// It is useful for tests and for the processes which don't need to replay events after restarting.
+// Events are copied when they're saved and returned, so that the stored history isn't changed by callers.
+type MemoryEventStore struct {
+	mu        sync.Mutex
+	events    map[int]*Event
+	processed map[int]struct{}
+}
+
+// NewMemoryEventStore creates an empty MemoryEventStore.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{
+		events:    make(map[int]*Event),
+		processed: make(map[int]struct{}),
+	}
+}
+
+// Save implements EventStore.
+func (m *MemoryEventStore) Save(ctx context.Context, event *Event) (bool, error) {
+	if err := validateStoredEvent(event); err != nil {
+		return false, err
+	}
+	stored, err := copyEvent(event)
+	if err != nil {
+		return false, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.events[*event.EventID]; ok {
+		return false, nil
+	}
+	m.events[*event.EventID] = stored
+	return true, nil
+}
+
+// MarkProcessed implements EventStore.
+func (m *MemoryEventStore) MarkProcessed(ctx context.Context, eventID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.events[eventID]; !ok {
+		return errors.Errorf("event %d is not stored", eventID)
+	}
+	m.processed[eventID] = struct{}{}
+	return nil
+}
+
+// IsProcessed implements EventStore.
+func (m *MemoryEventStore) IsProcessed(ctx context.Context, eventID int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.processed[eventID]
+	return ok, nil
+}
+
+// Events implements EventStore.
+func (m *MemoryEventStore) Events(ctx context.Context, from, to time.Time) ([]*Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	events := make([]*Event, 0, len(m.events))
+	for _, event := range filterEvents(slices.Collect(maps.Values(m.events)), from, to) {
+		copied, err := copyEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, copied)
+	}
+	return events, nil
+}
+
+// copyEvent returns a deep copy of the event.
+func copyEvent(event *Event) (*Event, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode event")
+	}
+	copied := new(Event)
+	if err := json.Unmarshal(b, copied); err != nil {
+		return nil, errors.Wrap(err, "failed to decode event")
+	}
+	return copied, nil
+}
+
+func validateStoredEvent(event *Event) error {
+	if event.EventID == nil {
+		return errors.New("event has no ID")
+	}
+	if eventTime(event).IsZero() {
+		return errors.Errorf("event %d has neither timestamp nor creation time", *event.EventID)
+	}
+	return nil
+}
+
+func eventTime(event *Event) time.Time {
+	switch {
+	case event.Timestamp != nil:
+		return *event.Timestamp
+	case event.CreatedAt != nil:
+		return *event.CreatedAt
+	}
+	return time.Time{}
+}
+
+// filterEvents returns the events whose time is in [from, to), ordered by time and ID.
+func filterEvents(events []*Event, from, to time.Time) []*Event {
+	filtered := make([]*Event, 0, len(events))
+	for _, event := range events {
+		t := eventTime(event)
+		if !t.Before(from) && t.Before(to) {
+			filtered = append(filtered, event)
+		}
+	}
+	slices.SortFunc(filtered, func(a, b *Event) int {
+		if c := eventTime(a).Compare(eventTime(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(*a.EventID, *b.EventID)
+	})
+	return filtered
+}
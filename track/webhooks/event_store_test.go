@@ -0,0 +1,586 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func newStoredEvent(eventID int, timestamp time.Time) *Event {
+	return &Event{
+		EventID:   track.Ptr(eventID),
+		Metadata:  &EventMetadata{Model: track.Ptr("tag"), Action: track.Ptr("created")},
+		Payload:   []byte(`{"id":4567890,"name":"MyTag"}`),
+		Timestamp: track.Ptr(timestamp),
+	}
+}
+
+func eventIDs(events []*Event) []int {
+	ids := []int{}
+	for _, event := range events {
+		ids = append(ids, *event.EventID)
+	}
+	return ids
+}
+
+func openFileEventStore(t *testing.T, name string) *FileEventStore {
+	store, err := OpenFileEventStore(name)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestEventStore(t *testing.T) {
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   func(t *testing.T) EventStore
+	}{
+		{
+			name: "memory",
+			in: func(t *testing.T) EventStore {
+				return NewMemoryEventStore()
+			},
+		},
+		{
+			name: "file",
+			in: func(t *testing.T) EventStore {
+				return openFileEventStore(t, filepath.Join(t.TempDir(), "events.jsonl"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := tt.in(t)
+			ctx := context.Background()
+
+			for _, event := range []*Event{
+				newStoredEvent(3, base.Add(2*time.Hour)),
+				newStoredEvent(1, base),
+				newStoredEvent(4, base.Add(time.Hour)),
+				newStoredEvent(2, base.Add(time.Hour)),
+			} {
+				if saved, err := store.Save(ctx, event); err != nil || !saved {
+					t.Fatalf("failed to save event %d: %v", *event.EventID, err)
+				}
+			}
+			saved, err := store.Save(ctx, newStoredEvent(1, base.Add(3*time.Hour)))
+			if err != nil || saved {
+				internal.Errorf(t, saved, false)
+			}
+			if _, err := store.Save(ctx, &Event{Timestamp: &base}); err == nil {
+				internal.Errorf(t, err, "event has no ID")
+			}
+
+			events, err := store.Events(ctx, base, base.Add(2*time.Hour))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if got, want := eventIDs(events), []int{1, 2, 4}; !reflect.DeepEqual(got, want) {
+				internal.Errorf(t, got, want)
+			}
+			if !reflect.DeepEqual(events[0], newStoredEvent(1, base)) {
+				internal.Errorf(t, events[0], newStoredEvent(1, base))
+			}
+
+			if processed, err := store.IsProcessed(ctx, 1); err != nil || processed {
+				internal.Errorf(t, processed, false)
+			}
+			if err := store.MarkProcessed(ctx, 1); err != nil {
+				t.Fatal(err.Error())
+			}
+			if processed, err := store.IsProcessed(ctx, 1); err != nil || !processed {
+				internal.Errorf(t, processed, true)
+			}
+			if processed, err := store.IsProcessed(ctx, 2); err != nil || processed {
+				internal.Errorf(t, processed, false)
+			}
+			if err := store.MarkProcessed(ctx, 5); err == nil {
+				internal.Errorf(t, err, "event 5 is not stored")
+			}
+		})
+	}
+}
+
+func TestMemoryEventStoreCopiesEvents(t *testing.T) {
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := context.Background()
+	store := NewMemoryEventStore()
+
+	event := newStoredEvent(1, base)
+	if _, err := store.Save(ctx, event); err != nil {
+		t.Fatal(err.Error())
+	}
+	*event.Metadata.Action = "deleted"
+
+	events, err := store.Events(ctx, base, base.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	*events[0].EventID = 2
+
+	events, err = store.Events(ctx, base, base.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(events[0], newStoredEvent(1, base)) {
+		internal.Errorf(t, events[0], newStoredEvent(1, base))
+	}
+}
+
+func TestFileEventStoreReopen(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "events.jsonl")
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := context.Background()
+
+	store, err := OpenFileEventStore(name)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := store.Save(ctx, newStoredEvent(1, base)); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := store.MarkProcessed(ctx, 1); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Emulate a crash in the middle of writing an event.
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	file.WriteString(`{"event_id":2,"timestamp":"2022-01-02T`)
+	file.Close()
+
+	store = openFileEventStore(t, name)
+	if saved, err := store.Save(ctx, newStoredEvent(1, base)); err != nil || saved {
+		internal.Errorf(t, saved, false)
+	}
+	if saved, err := store.Save(ctx, newStoredEvent(2, base.Add(time.Hour))); err != nil || !saved {
+		internal.Errorf(t, saved, true)
+	}
+	events, err := store.Events(ctx, base, base.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got, want := eventIDs(events), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+	if processed, err := store.IsProcessed(ctx, 1); err != nil || !processed {
+		internal.Errorf(t, processed, true)
+	}
+}
+
+func TestFileEventStoreCompact(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "events.jsonl")
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := context.Background()
+
+	store, err := OpenFileEventStore(name)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// The old processed event is dropped, while the new one and the unprocessed one are kept.
+	for _, event := range []*Event{newStoredEvent(1, base), newStoredEvent(2, base.Add(2*time.Hour)), newStoredEvent(3, base)} {
+		if _, err := store.Save(ctx, event); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	for _, id := range []int{1, 2} {
+		if err := store.MarkProcessed(ctx, id); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if err := store.Compact(ctx, base.Add(time.Hour)); err != nil {
+		t.Fatal(err.Error())
+	}
+	if saved, err := store.Save(ctx, newStoredEvent(4, base.Add(3*time.Hour))); err != nil || !saved {
+		internal.Errorf(t, saved, true)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	store = openFileEventStore(t, name)
+	events, err := store.Events(ctx, base, base.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got, want := eventIDs(events), []int{3, 2, 4}; !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+	for id, want := range map[int]bool{1: false, 2: true, 3: false, 4: false} {
+		if processed, err := store.IsProcessed(ctx, id); err != nil || processed != want {
+			internal.Errorf(t, processed, want)
+		}
+	}
+	matches, err := filepath.Glob(name + ".*.tmp")
+	if err != nil || len(matches) != 0 {
+		internal.Errorf(t, matches, "no temporary files")
+	}
+}
+
+// failingFile fails to write after writing half of the bytes, as if the disk became full.
+type failingFile struct {
+	*os.File
+	fail bool
+}
+
+func (f *failingFile) Write(b []byte) (int, error) {
+	if !f.fail {
+		return f.File.Write(b)
+	}
+	n, _ := f.File.Write(b[:len(b)/2])
+	return n, errors.New("no space left on device")
+}
+
+func TestFileEventStoreWriteFailure(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "events.jsonl")
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := context.Background()
+
+	store := openFileEventStore(t, name)
+	if _, err := store.Save(ctx, newStoredEvent(1, base)); err != nil {
+		t.Fatal(err.Error())
+	}
+	file := &failingFile{File: store.file.(*os.File), fail: true}
+	store.file = file
+	if saved, err := store.Save(ctx, newStoredEvent(2, base)); err == nil || saved {
+		internal.Errorf(t, err, "no space left on device")
+	}
+	file.fail = false
+	if saved, err := store.Save(ctx, newStoredEvent(3, base)); err != nil || !saved {
+		internal.Errorf(t, saved, true)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	store = openFileEventStore(t, name)
+	events, err := store.Events(ctx, base, base.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if got, want := eventIDs(events), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	base := time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)
+	ctx := context.Background()
+	store := NewMemoryEventStore()
+	for i := 1; i <= 4; i++ {
+		store.Save(ctx, newStoredEvent(i, base.Add(time.Duration(i)*time.Minute)))
+	}
+
+	var replayed []int
+	err := Replay(ctx, store, base.Add(2*time.Minute), base.Add(time.Hour), func(ctx context.Context, event *Event) error {
+		replayed = append(replayed, *event.EventID)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if want := []int{2, 3, 4}; !reflect.DeepEqual(replayed, want) {
+		internal.Errorf(t, replayed, want)
+	}
+
+	replayed = nil
+	err = Replay(ctx, store, base, base.Add(time.Hour), func(ctx context.Context, event *Event) error {
+		replayed = append(replayed, *event.EventID)
+		if *event.EventID == 2 {
+			return errors.New("database is down")
+		}
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to replay event 2") {
+		internal.Errorf(t, err, "failed to replay event 2")
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(replayed, want) {
+		internal.Errorf(t, replayed, want)
+	}
+}
+
+type failingEventStore struct {
+	*MemoryEventStore
+}
+
+func (f *failingEventStore) Save(ctx context.Context, event *Event) (bool, error) {
+	return false, errors.New("disk is full")
+}
+
+func TestHandlerWithEventStore(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			eventStore EventStore
+			eventErr   error
+			deliveries int
+		}
+		out struct {
+			statusCode int
+			calls      int
+			errors     int
+		}
+	}{
+		{
+			name: "duplicate deliveries",
+			in: struct {
+				eventStore EventStore
+				eventErr   error
+				deliveries int
+			}{
+				eventStore: NewMemoryEventStore(),
+				deliveries: 3,
+			},
+			out: struct {
+				statusCode int
+				calls      int
+				errors     int
+			}{
+				statusCode: http.StatusOK,
+				calls:      1,
+				errors:     0,
+			},
+		},
+		{
+			name: "event func error",
+			in: struct {
+				eventStore EventStore
+				eventErr   error
+				deliveries int
+			}{
+				eventStore: NewMemoryEventStore(),
+				eventErr:   errors.New("database is down"),
+				deliveries: 2,
+			},
+			out: struct {
+				statusCode int
+				calls      int
+				errors     int
+			}{
+				statusCode: http.StatusInternalServerError,
+				calls:      2,
+				errors:     2,
+			},
+		},
+		{
+			name: "store error",
+			in: struct {
+				eventStore EventStore
+				eventErr   error
+				deliveries int
+			}{
+				eventStore: &failingEventStore{NewMemoryEventStore()},
+				deliveries: 2,
+			},
+			out: struct {
+				statusCode int
+				calls      int
+				errors     int
+			}{
+				statusCode: http.StatusInternalServerError,
+				calls:      0,
+				errors:     2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls, errs := 0, 0
+			handler := NewHandler(secret,
+				WithEventStore(tt.in.eventStore),
+				WithEventFunc(func(ctx context.Context, event *Event) error {
+					calls++
+					return tt.in.eventErr
+				}),
+				WithErrorFunc(func(r *http.Request, err error) {
+					errs++
+				}),
+			)
+
+			var rec *httptest.ResponseRecorder
+			for i := 0; i < tt.in.deliveries; i++ {
+				rec = httptest.NewRecorder()
+				handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+			}
+
+			if rec.Code != tt.out.statusCode {
+				internal.Errorf(t, rec.Code, tt.out.statusCode)
+			}
+			if calls != tt.out.calls {
+				internal.Errorf(t, calls, tt.out.calls)
+			}
+			if errs != tt.out.errors {
+				internal.Errorf(t, errs, tt.out.errors)
+			}
+		})
+	}
+}
+
+func TestHandlerWithEventStoreRedelivery(t *testing.T) {
+	store := NewMemoryEventStore()
+	calls := 0
+	handler := NewHandler(secret,
+		WithEventStore(store),
+		WithEventFunc(func(ctx context.Context, event *Event) error {
+			calls++
+			if calls == 1 {
+				return errors.New("database is down")
+			}
+			return nil
+		}),
+	)
+
+	// The first delivery fails, the redelivery succeeds, and the last one is a duplicate.
+	for _, want := range []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+		if rec.Code != want {
+			internal.Errorf(t, rec.Code, want)
+		}
+	}
+	if calls != 2 {
+		internal.Errorf(t, calls, 2)
+	}
+	if processed, err := store.IsProcessed(context.Background(), 6789012); err != nil || !processed {
+		internal.Errorf(t, processed, true)
+	}
+}
+
+func TestHandlerWithEventStoreMalformedEvent(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "without ID", in: `{"timestamp":"2022-01-02T03:04:06Z","payload":{"id":4567890}}`},
+		{name: "without time", in: `{"event_id":6789012,"payload":{"id":4567890}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryEventStore()
+			calls := 0
+			handler := NewHandler(secret,
+				WithEventStore(store),
+				WithEventFunc(func(ctx context.Context, event *Event) error {
+					calls++
+					return nil
+				}),
+			)
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.in))
+			req.Header.Set(signatureHeader, Signature(secret, []byte(tt.in)))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				internal.Errorf(t, rec.Code, http.StatusBadRequest)
+			}
+			if calls != 0 {
+				internal.Errorf(t, calls, 0)
+			}
+			events, err := store.Events(context.Background(), time.Time{}, time.Now())
+			if err != nil || len(events) != 0 {
+				internal.Errorf(t, events, "no stored events")
+			}
+		})
+	}
+}
+
+func TestHandlerWithEventStoreConcurrentDeliveries(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := NewHandler(secret,
+		WithEventStore(NewMemoryEventStore()),
+		WithEventFunc(func(ctx context.Context, event *Event) error {
+			calls.Add(1)
+			close(started)
+			<-release
+			return nil
+		}),
+	)
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(first, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+	}()
+	<-started
+
+	// The deliveries while the first one is being handled are rejected without calling the callback.
+	var wg sync.WaitGroup
+	codes := make([]int, 5)
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+			codes[i] = rec.Code
+		}()
+	}
+	wg.Wait()
+	close(release)
+	<-done
+
+	for _, code := range codes {
+		if code != http.StatusConflict {
+			internal.Errorf(t, code, http.StatusConflict)
+		}
+	}
+	if first.Code != http.StatusOK {
+		internal.Errorf(t, first.Code, http.StatusOK)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+	if rec.Code != http.StatusOK {
+		internal.Errorf(t, rec.Code, http.StatusOK)
+	}
+	if got := calls.Load(); got != 1 {
+		internal.Errorf(t, got, 1)
+	}
+}
+
+func TestHandlerWithEventStoreReplay(t *testing.T) {
+	store := NewMemoryEventStore()
+	handler := NewHandler(secret, WithEventStore(store))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+	if rec.Code != http.StatusOK {
+		internal.Errorf(t, rec.Code, http.StatusOK)
+	}
+
+	var got *TimeEntryEvent
+	router := NewRouter()
+	router.OnTimeEntryCreated(func(ctx context.Context, event *TimeEntryEvent) error {
+		got = event
+		return nil
+	})
+	from := time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC)
+	if err := Replay(context.Background(), store, from, from.AddDate(0, 0, 1), router.HandleEvent); err != nil {
+		t.Fatal(err.Error())
+	}
+	if got == nil || *got.EventID != 6789012 || *got.TimeEntry.Description != "MyTimeEntry" {
+		internal.Errorf(t, got, "the replayed time entry event")
+	}
+}
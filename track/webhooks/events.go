@@ -0,0 +1,180 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+)
+
+// Entity returns the entity of the event, or an empty string if it has no metadata.
+func (e *Event) Entity() Entity {
+	if e.Metadata == nil || e.Metadata.Model == nil {
+		return ""
+	}
+	return Entity(*e.Metadata.Model)
+}
+
+// Action returns the action of the event, or an empty string if it has no metadata.
+func (e *Event) Action() Action {
+	if e.Metadata == nil || e.Metadata.Action == nil {
+		return ""
+	}
+	return Action(*e.Metadata.Action)
+}
+
+// ProjectGroup represents the properties of a group which is assigned to a project.
+type ProjectGroup struct {
+	ID          *int       `json:"id,omitempty"`
+	ProjectID   *int       `json:"project_id,omitempty"`
+	GroupID     *int       `json:"group_id,omitempty"`
+	WorkspaceID *int       `json:"workspace_id,omitempty"`
+	At          *time.Time `json:"at,omitempty"`
+}
+
+// ClientEvent represents an event of a client.
+type ClientEvent struct {
+	*Event
+	Client *toggl.Client
+}
+
+// ProjectEvent represents an event of a project.
+type ProjectEvent struct {
+	*Event
+	Project *toggl.Project
+}
+
+// ProjectGroupEvent represents an event of a group assigned to a project.
+type ProjectGroupEvent struct {
+	*Event
+	ProjectGroup *ProjectGroup
+}
+
+// ProjectUserEvent represents an event of a project user.
+type ProjectUserEvent struct {
+	*Event
+	ProjectUser *toggl.ProjectUser
+}
+
+// TagEvent represents an event of a tag.
+type TagEvent struct {
+	*Event
+	Tag *toggl.Tag
+}
+
+// TaskEvent represents an event of a task.
+type TaskEvent struct {
+	*Event
+	Task *toggl.Task
+}
+
+// TimeEntryEvent represents an event of a time entry.
+type TimeEntryEvent struct {
+	*Event
+	TimeEntry *toggl.TimeEntry
+}
+
+// WorkspaceEvent represents an event of a workspace.
+type WorkspaceEvent struct {
+	*Event
+	Workspace *toggl.Workspace
+}
+
+// WorkspaceUserEvent represents an event of a workspace user.
+type WorkspaceUserEvent struct {
+	*Event
+	WorkspaceUser *toggl.WorkspaceUser
+}
+
+// DecodeClientEvent decodes the payload of the event as a client.
+func DecodeClientEvent(event *Event) (*ClientEvent, error) {
+	client, err := decodePayload[toggl.Client](event, EntityClient)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientEvent{Event: event, Client: client}, nil
+}
+
+// DecodeProjectEvent decodes the payload of the event as a project.
+func DecodeProjectEvent(event *Event) (*ProjectEvent, error) {
+	project, err := decodePayload[toggl.Project](event, EntityProject)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectEvent{Event: event, Project: project}, nil
+}
+
+// DecodeProjectGroupEvent decodes the payload of the event as a group assigned to a project.
+func DecodeProjectGroupEvent(event *Event) (*ProjectGroupEvent, error) {
+	projectGroup, err := decodePayload[ProjectGroup](event, EntityProjectGroup)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectGroupEvent{Event: event, ProjectGroup: projectGroup}, nil
+}
+
+// DecodeProjectUserEvent decodes the payload of the event as a project user.
+func DecodeProjectUserEvent(event *Event) (*ProjectUserEvent, error) {
+	projectUser, err := decodePayload[toggl.ProjectUser](event, EntityProjectUser)
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectUserEvent{Event: event, ProjectUser: projectUser}, nil
+}
+
+// DecodeTagEvent decodes the payload of the event as a tag.
+func DecodeTagEvent(event *Event) (*TagEvent, error) {
+	tag, err := decodePayload[toggl.Tag](event, EntityTag)
+	if err != nil {
+		return nil, err
+	}
+	return &TagEvent{Event: event, Tag: tag}, nil
+}
+
+// DecodeTaskEvent decodes the payload of the event as a task.
+func DecodeTaskEvent(event *Event) (*TaskEvent, error) {
+	task, err := decodePayload[toggl.Task](event, EntityTask)
+	if err != nil {
+		return nil, err
+	}
+	return &TaskEvent{Event: event, Task: task}, nil
+}
+
+// DecodeTimeEntryEvent decodes the payload of the event as a time entry.
+func DecodeTimeEntryEvent(event *Event) (*TimeEntryEvent, error) {
+	timeEntry, err := decodePayload[toggl.TimeEntry](event, EntityTimeEntry)
+	if err != nil {
+		return nil, err
+	}
+	return &TimeEntryEvent{Event: event, TimeEntry: timeEntry}, nil
+}
+
+// DecodeWorkspaceEvent decodes the payload of the event as a workspace.
+func DecodeWorkspaceEvent(event *Event) (*WorkspaceEvent, error) {
+	workspace, err := decodePayload[toggl.Workspace](event, EntityWorkspace)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkspaceEvent{Event: event, Workspace: workspace}, nil
+}
+
+// DecodeWorkspaceUserEvent decodes the payload of the event as a workspace user.
+func DecodeWorkspaceUserEvent(event *Event) (*WorkspaceUserEvent, error) {
+	workspaceUser, err := decodePayload[toggl.WorkspaceUser](event, EntityWorkspaceUser)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkspaceUserEvent{Event: event, WorkspaceUser: workspaceUser}, nil
+}
+
+func decodePayload[T any](event *Event, entity Entity) (*T, error) {
+	if event.Entity() != entity {
+		return nil, errors.Errorf("event of %q is not an event of %q", event.Entity(), entity)
+	}
+	var payload *T
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the payload of %s", entity)
+	}
+	return payload, nil
+}
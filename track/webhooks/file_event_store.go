@@ -0,0 +1,298 @@
+package webhooks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FileEventStore is an EventStore which appends events and processed markers to a local file as JSON Lines.
+// Every line is synced to the disk before Save and MarkProcessed return, so that it survives a crash of the process.
+// The events are also kept in memory, so that Events doesn't read the file.
+//
+// The file grows without limit since every event is kept. Call Compact periodically to drop old processed events.
+type FileEventStore struct {
+	mu        sync.Mutex
+	name      string
+	file      eventFile
+	size      int64
+	events    map[int]*Event
+	processed map[int]struct{}
+}
+
+// eventFile is the subset of *os.File used by FileEventStore.
+type eventFile interface {
+	io.ReaderAt
+	io.Writer
+	io.Seeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+// processedMarker is the line which records that an event has been processed.
+type processedMarker struct {
+	ProcessedEventID *int `json:"processed_event_id,omitempty"`
+}
+
+// OpenFileEventStore opens the file of a FileEventStore, creating it if it doesn't exist.
+// A line which was partially written when the process crashed is discarded.
+// The caller should call Close to close the file.
+func OpenFileEventStore(name string) (*FileEventStore, error) {
+	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open event store")
+	}
+	f := &FileEventStore{
+		name:   name,
+		file:   file,
+		events: make(map[int]*Event),
+	}
+
+	events, processed, size, err := f.read()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	for _, event := range events {
+		f.events[*event.EventID] = event
+	}
+	f.processed = processed
+	if err := f.truncate(size); err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "failed to discard a partially written line")
+	}
+	return f, nil
+}
+
+// Save implements EventStore.
+func (f *FileEventStore) Save(ctx context.Context, event *Event) (bool, error) {
+	if err := validateStoredEvent(event); err != nil {
+		return false, err
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to encode event")
+	}
+	stored := new(Event)
+	if err := json.Unmarshal(line, stored); err != nil {
+		return false, errors.Wrap(err, "failed to decode event")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.events[*event.EventID]; ok {
+		return false, nil
+	}
+	if err := f.append(line); err != nil {
+		return false, errors.Wrap(err, "failed to save event")
+	}
+	f.events[*event.EventID] = stored
+	return true, nil
+}
+
+// MarkProcessed implements EventStore.
+func (f *FileEventStore) MarkProcessed(ctx context.Context, eventID int) error {
+	line, err := json.Marshal(&processedMarker{ProcessedEventID: &eventID})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode processed marker")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.events[eventID]; !ok {
+		return errors.Errorf("event %d is not stored", eventID)
+	}
+	if _, ok := f.processed[eventID]; ok {
+		return nil
+	}
+	if err := f.append(line); err != nil {
+		return errors.Wrapf(err, "failed to mark event %d as processed", eventID)
+	}
+	f.processed[eventID] = struct{}{}
+	return nil
+}
+
+// IsProcessed implements EventStore.
+func (f *FileEventStore) IsProcessed(ctx context.Context, eventID int) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.processed[eventID]
+	return ok, nil
+}
+
+// Events implements EventStore.
+func (f *FileEventStore) Events(ctx context.Context, from, to time.Time) ([]*Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	events := make([]*Event, 0, len(f.events))
+	for _, event := range filterEvents(slices.Collect(maps.Values(f.events)), from, to) {
+		copied, err := copyEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, copied)
+	}
+	return events, nil
+}
+
+// Compact rewrites the file without the processed events whose time is before the given time.
+// A dropped event is handled again if it's delivered again, so the time should be older than
+// the period in which Toggl retries deliveries, and than the events which may be replayed.
+// The file is replaced atomically by renaming a new file, so a crash leaves either the old or the new one.
+func (f *FileEventStore) Compact(ctx context.Context, before time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var buf bytes.Buffer
+	kept := make(map[int]*Event, len(f.events))
+	for _, event := range filterEvents(slices.Collect(maps.Values(f.events)), time.Time{}, maxTime) {
+		_, processed := f.processed[*event.EventID]
+		if processed && eventTime(event).Before(before) {
+			continue
+		}
+		kept[*event.EventID] = event
+		line, err := json.Marshal(event)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode event")
+		}
+		buf.Write(append(line, '\n'))
+		if processed {
+			line, err := json.Marshal(&processedMarker{ProcessedEventID: event.EventID})
+			if err != nil {
+				return errors.Wrap(err, "failed to encode processed marker")
+			}
+			buf.Write(append(line, '\n'))
+		}
+	}
+
+	file, err := f.replace(buf.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "failed to compact event store")
+	}
+	f.file.Close()
+	f.file = file
+	f.size = int64(buf.Len())
+	f.events = kept
+	for id := range f.processed {
+		if _, ok := kept[id]; !ok {
+			delete(f.processed, id)
+		}
+	}
+	return nil
+}
+
+// maxTime is later than the time of any event.
+var maxTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// replace writes the content to a temporary file, renames it to the file of the store, and returns it opened.
+func (f *FileEventStore) replace(content []byte) (*os.File, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(f.name), filepath.Base(f.name)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), f.name); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	// The temporary file is now the file of the store, and its offset is at the end.
+	return tmp, nil
+}
+
+// Close closes the file.
+func (f *FileEventStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+// append writes the line at the end of the file and syncs it.
+// If either fails, the file is truncated back so that a partial line isn't followed by the next one.
+func (f *FileEventStore) append(line []byte) error {
+	if _, err := f.file.Write(append(line, '\n')); err != nil {
+		return f.rollback(errors.Wrap(err, "failed to write"))
+	}
+	if err := f.file.Sync(); err != nil {
+		return f.rollback(errors.Wrap(err, "failed to sync"))
+	}
+	f.size += int64(len(line)) + 1
+	return nil
+}
+
+// rollback discards what was written after the last complete line, and returns err.
+func (f *FileEventStore) rollback(err error) error {
+	if truncateErr := f.truncate(f.size); truncateErr != nil {
+		return errors.Wrapf(err, "failed to discard a partially written line (%v)", truncateErr)
+	}
+	return err
+}
+
+// truncate truncates the file to the size and moves the offset to the end.
+func (f *FileEventStore) truncate(size int64) error {
+	if err := f.file.Truncate(size); err != nil {
+		return err
+	}
+	if _, err := f.file.Seek(size, io.SeekStart); err != nil {
+		return err
+	}
+	f.size = size
+	return nil
+}
+
+// read reads the events and the IDs of the processed events from the beginning of the file.
+// It also returns the size of the complete lines, which excludes a partially written line at the end.
+func (f *FileEventStore) read() ([]*Event, map[int]struct{}, int64, error) {
+	reader := bufio.NewReader(io.NewSectionReader(f.file, 0, 1<<62))
+	var events []*Event
+	processed := make(map[int]struct{})
+	var size int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// The last line without a newline is partially written, since every line ends with a newline.
+			return events, processed, size, nil
+		}
+		if err != nil {
+			return nil, nil, 0, errors.Wrap(err, "failed to read event store")
+		}
+		marker := new(processedMarker)
+		if err := json.Unmarshal(bytes.TrimSpace(line), marker); err != nil {
+			return nil, nil, 0, errors.Wrapf(err, "failed to decode the line at offset %d", size)
+		}
+		if marker.ProcessedEventID != nil {
+			processed[*marker.ProcessedEventID] = struct{}{}
+			size += int64(len(line))
+			continue
+		}
+		event := new(Event)
+		if err := json.Unmarshal(bytes.TrimSpace(line), event); err != nil {
+			return nil, nil, 0, errors.Wrapf(err, "failed to decode the event at offset %d", size)
+		}
+		if err := validateStoredEvent(event); err != nil {
+			return nil, nil, 0, errors.Wrapf(err, "invalid event at offset %d", size)
+		}
+		events = append(events, event)
+		size += int64(len(line))
+	}
+}
@@ -0,0 +1,314 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	signatureHeader string = "X-Webhook-Signature-256"
+	signaturePrefix string = "sha256="
+
+	defaultMaxBodyBytes int64 = 1 << 20
+)
+
+// Event represents the envelope of a webhook event delivered by Toggl.
+type Event struct {
+	EventID           *int            `json:"event_id,omitempty"`
+	CreatedAt         *time.Time      `json:"created_at,omitempty"`
+	CreatorID         *int            `json:"creator_id,omitempty"`
+	Metadata          *EventMetadata  `json:"metadata,omitempty"`
+	Payload           json.RawMessage `json:"payload,omitempty"`
+	SubscriptionID    *int            `json:"subscription_id,omitempty"`
+	Timestamp         *time.Time      `json:"timestamp,omitempty"`
+	URLCallback       *string         `json:"url_callback,omitempty"`
+	ValidationCode    *string         `json:"validation_code,omitempty"`
+	ValidationCodeURL *string         `json:"validation_code_url,omitempty"`
+}
+
+// EventMetadata represents the metadata of a webhook event, which tells the entity and the action of the event.
+type EventMetadata struct {
+	Action      *string `json:"action,omitempty"`
+	EventUserID *string `json:"event_user_id,omitempty"`
+	Model       *string `json:"model,omitempty"`
+	Path        *string `json:"path,omitempty"`
+	RequestType *string `json:"request_type,omitempty"`
+	WorkspaceID *string `json:"workspace_id,omitempty"`
+}
+
+// IsPing reports whether the event is a ping, which is sent to validate the URL callback or by Ping.
+func (e *Event) IsPing() bool {
+	var payload string
+	return json.Unmarshal(e.Payload, &payload) == nil && payload == "ping"
+}
+
+// Signature returns the value of X-Webhook-Signature-256 for the body signed with the secret.
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether the signature is valid for the body and the secret.
+// It compares them in constant time.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	hexSignature, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// Handler is an http.Handler which receives webhook events from Toggl.
+// It verifies their signatures, answers the validation of the URL callback, and calls the callbacks for the other events.
+// It is safe for concurrent use by multiple goroutines if the callbacks are.
+type Handler struct {
+	secret       string
+	maxBodyBytes int64
+	eventFunc    func(ctx context.Context, event *Event) error
+	pingFunc     func(ctx context.Context, event *Event)
+	errorFunc    func(r *http.Request, err error)
+	eventStore   EventStore
+
+	mu       sync.Mutex
+	inFlight map[int]struct{}
+}
+
+// NewHandler creates a new Handler which verifies events with the secret of the subscription.
+// It panics if the secret is empty, since any body signed with an empty key would be accepted.
+func NewHandler(secret string, options ...HandlerOption) *Handler {
+	if secret == "" {
+		panic("webhooks: NewHandler requires a non-empty secret")
+	}
+	newHandler := &Handler{
+		secret:       secret,
+		maxBodyBytes: defaultMaxBodyBytes,
+		inFlight:     make(map[int]struct{}),
+	}
+
+	for _, option := range options {
+		option.apply(newHandler)
+	}
+
+	return newHandler
+}
+
+// HandlerOption is an option for a Handler.
+type HandlerOption interface {
+	apply(*Handler)
+}
+
+// WithEventFunc returns a HandlerOption that specifies the callback for the events except pings.
+// If it returns an error, the handler responds 500 Internal Server Error so that Toggl delivers the event again.
+func WithEventFunc(eventFunc func(ctx context.Context, event *Event) error) HandlerOption {
+	return eventFuncOption(eventFunc)
+}
+
+type eventFuncOption func(ctx context.Context, event *Event) error
+
+func (e eventFuncOption) apply(h *Handler) {
+	h.eventFunc = e
+}
+
+// WithPingFunc returns a HandlerOption that specifies the callback for pings.
+// Pings are answered whether the callback is specified or not.
+func WithPingFunc(pingFunc func(ctx context.Context, event *Event)) HandlerOption {
+	return pingFuncOption(pingFunc)
+}
+
+type pingFuncOption func(ctx context.Context, event *Event)
+
+func (p pingFuncOption) apply(h *Handler) {
+	h.pingFunc = p
+}
+
+// WithErrorFunc returns a HandlerOption that specifies the callback for requests which fail to be handled,
+// such as the ones with an invalid signature.
+func WithErrorFunc(errorFunc func(r *http.Request, err error)) HandlerOption {
+	return errorFuncOption(errorFunc)
+}
+
+type errorFuncOption func(r *http.Request, err error)
+
+func (e errorFuncOption) apply(h *Handler) {
+	h.errorFunc = e
+}
+
+// WithEventStore returns a HandlerOption that specifies the store which events are saved to before they're handled.
+// An event is marked as processed after the event callback succeeds, and redeliveries of processed events are
+// acknowledged without calling the callback again. If the callback fails or the process crashes before the event
+// is marked, the handler doesn't acknowledge it, so the callback may be called more than once for an event.
+// While the callback is handling an event, concurrent deliveries of the same event are answered with
+// 409 Conflict without calling it, so that Toggl delivers them again later. This holds within a Handler,
+// so handlers sharing a store across processes may still call the callback concurrently for an event.
+func WithEventStore(eventStore EventStore) HandlerOption {
+	return &eventStoreOption{eventStore: eventStore}
+}
+
+type eventStoreOption struct {
+	eventStore EventStore
+}
+
+func (e *eventStoreOption) apply(h *Handler) {
+	h.eventStore = e.eventStore
+}
+
+// WithMaxBodyBytes returns a HandlerOption that specifies the maximum size of a request body.
+// The default is 1 MiB.
+func WithMaxBodyBytes(maxBodyBytes int64) HandlerOption {
+	return maxBodyBytesOption(maxBodyBytes)
+}
+
+type maxBodyBytesOption int64
+
+func (m maxBodyBytesOption) apply(h *Handler) {
+	h.maxBodyBytes = int64(m)
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, errors.Errorf("method %s is not allowed", r.Method))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err != nil {
+		maxBytesError := new(http.MaxBytesError)
+		if errors.As(err, &maxBytesError) {
+			h.fail(w, r, http.StatusRequestEntityTooLarge, errors.Wrap(err, "failed to read the request body"))
+			return
+		}
+		h.fail(w, r, http.StatusBadRequest, errors.Wrap(err, "failed to read the request body"))
+		return
+	}
+
+	if !VerifySignature(h.secret, body, r.Header.Get(signatureHeader)) {
+		h.fail(w, r, http.StatusUnauthorized, errors.New("invalid signature"))
+		return
+	}
+
+	event := new(Event)
+	if err := json.Unmarshal(body, event); err != nil {
+		h.fail(w, r, http.StatusBadRequest, errors.Wrap(err, "failed to decode the event"))
+		return
+	}
+
+	if event.IsPing() {
+		h.ping(w, r, event)
+		return
+	}
+
+	if h.eventStore != nil {
+		h.saveAndHandle(w, r, event)
+		return
+	}
+
+	if h.eventFunc != nil {
+		if err := h.eventFunc(r.Context(), event); err != nil {
+			h.fail(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to handle the event"))
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// saveAndHandle saves the event to the store, and then calls the event callback unless the event has been processed.
+func (h *Handler) saveAndHandle(w http.ResponseWriter, r *http.Request, event *Event) {
+	// A malformed event is rejected before it's saved, so that a storage failure is the only cause of 500.
+	if err := validateStoredEvent(event); err != nil {
+		h.fail(w, r, http.StatusBadRequest, errors.Wrap(err, "invalid event"))
+		return
+	}
+	if _, err := h.eventStore.Save(r.Context(), event); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to save the event"))
+		return
+	}
+	if !h.claim(*event.EventID) {
+		h.fail(w, r, http.StatusConflict, errors.Errorf("event %d is being handled", *event.EventID))
+		return
+	}
+	defer h.release(*event.EventID)
+	// The event is checked after it's claimed, since the previous claimant marks it before releasing it.
+	processed, err := h.eventStore.IsProcessed(r.Context(), *event.EventID)
+	if err != nil {
+		h.fail(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to check if the event has been processed"))
+		return
+	}
+	if processed {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if h.eventFunc != nil {
+		if err := h.eventFunc(r.Context(), event); err != nil {
+			h.fail(w, r, http.StatusInternalServerError, errors.Wrapf(err, "failed to handle the event %d", *event.EventID))
+			return
+		}
+	}
+	if err := h.eventStore.MarkProcessed(r.Context(), *event.EventID); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, errors.Wrap(err, "failed to mark the event as processed"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// claim reports whether the event of the ID isn't being handled, and if so, records that it's being handled.
+func (h *Handler) claim(eventID int) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.inFlight[eventID]; ok {
+		return false
+	}
+	h.inFlight[eventID] = struct{}{}
+	return true
+}
+
+// release records that the event of the ID is no longer being handled.
+func (h *Handler) release(eventID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.inFlight, eventID)
+}
+
+// ping answers a ping. If the ping validates the URL callback, the validation code is echoed back.
+func (h *Handler) ping(w http.ResponseWriter, r *http.Request, event *Event) {
+	if h.pingFunc != nil {
+		h.pingFunc(r.Context(), event)
+	}
+	if event.ValidationCode == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(struct {
+		ValidationCode string `json:"validation_code"`
+	}{
+		ValidationCode: *event.ValidationCode,
+	})
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, statusCode int, err error) {
+	if h.errorFunc != nil {
+		h.errorFunc(r, err)
+	}
+	http.Error(w, http.StatusText(statusCode), statusCode)
+}
@@ -0,0 +1,234 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+const secret string = "secret"
+
+func newSignedRequest(t *testing.T, testdataFile string) *http.Request {
+	body, err := os.ReadFile(testdataFile)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+	req.Header.Set(signatureHeader, Signature(secret, body))
+	return req
+}
+
+func TestSignature(t *testing.T) {
+	// The expected value is computed by `printf '{}' | openssl dgst -sha256 -hmac secret`.
+	want := "sha256=77325902caca812dc259733aacd046b73817372c777b8d95b402647474516e13"
+	if got := Signature(secret, []byte("{}")); got != want {
+		internal.Errorf(t, got, want)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte("{}")
+	tests := []struct {
+		name string
+		in   string
+		out  bool
+	}{
+		{name: "valid", in: Signature(secret, body), out: true},
+		{name: "another secret", in: Signature("another_secret", body), out: false},
+		{name: "without prefix", in: strings.TrimPrefix(Signature(secret, body), "sha256="), out: false},
+		{name: "not hex", in: "sha256=signature", out: false},
+		{name: "empty", in: "", out: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(secret, body, tt.in); got != tt.out {
+				internal.Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
+
+func TestNewHandlerEmptySecret(t *testing.T) {
+	defer func() {
+		want := "webhooks: NewHandler requires a non-empty secret"
+		if r := recover(); r != want {
+			internal.Errorf(t, r, want)
+		}
+	}()
+	NewHandler("")
+}
+
+func TestHandlerEvent(t *testing.T) {
+	var got *Event
+	handler := NewHandler(secret, WithEventFunc(func(ctx context.Context, event *Event) error {
+		got = event
+		return nil
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, "testdata/handler/time_entry_created.json"))
+
+	if rec.Code != http.StatusOK {
+		internal.Errorf(t, rec.Code, http.StatusOK)
+	}
+	want := &Event{
+		EventID:   track.Ptr(6789012),
+		CreatedAt: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+		CreatorID: track.Ptr(3456789),
+		Metadata: &EventMetadata{
+			Action:      track.Ptr("created"),
+			EventUserID: track.Ptr("3456789"),
+			Model:       track.Ptr("time_entry"),
+			Path:        track.Ptr("/api/v9/workspaces/2345678/time_entries"),
+			RequestType: track.Ptr("POST"),
+			WorkspaceID: track.Ptr("2345678"),
+		},
+		SubscriptionID: track.Ptr(5678901),
+		Timestamp:      track.Ptr(time.Date(2022, time.January, 2, 3, 4, 6, 0, time.UTC)),
+		URLCallback:    track.Ptr("https://example.com/webhooks"),
+	}
+	if got == nil {
+		t.Fatal("event func was not called")
+	}
+	if !strings.Contains(string(got.Payload), "\"description\": \"MyTimeEntry\"") {
+		internal.Errorf(t, string(got.Payload), "payload of the time entry")
+	}
+	got.Payload = nil
+	if !reflect.DeepEqual(got, want) {
+		internal.Errorf(t, got, want)
+	}
+}
+
+func TestHandlerPing(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{
+			name: "validation",
+			in:   "testdata/handler/validation_ping.json",
+			out:  "{\"validation_code\":\"6f1b2a3c\"}\n",
+		},
+		{
+			name: "ping",
+			in:   "testdata/handler/ping.json",
+			out:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pinged := false
+			handler := NewHandler(secret,
+				WithEventFunc(func(ctx context.Context, event *Event) error {
+					t.Error("event func was called for ping")
+					return nil
+				}),
+				WithPingFunc(func(ctx context.Context, event *Event) {
+					pinged = true
+				}),
+			)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, newSignedRequest(t, tt.in))
+
+			if rec.Code != http.StatusOK {
+				internal.Errorf(t, rec.Code, http.StatusOK)
+			}
+			if rec.Body.String() != tt.out {
+				internal.Errorf(t, rec.Body.String(), tt.out)
+			}
+			if !pinged {
+				t.Error("ping func was not called")
+			}
+		})
+	}
+}
+
+func TestHandlerFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      func(t *testing.T) *http.Request
+		options []HandlerOption
+		out     int
+	}{
+		{
+			name: "GET",
+			in: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/webhooks", nil)
+			},
+			out: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "missing signature",
+			in: func(t *testing.T) *http.Request {
+				req := newSignedRequest(t, "testdata/handler/time_entry_created.json")
+				req.Header.Del(signatureHeader)
+				return req
+			},
+			out: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid signature",
+			in: func(t *testing.T) *http.Request {
+				req := newSignedRequest(t, "testdata/handler/time_entry_created.json")
+				req.Header.Set(signatureHeader, Signature("another_secret", []byte("{}")))
+				return req
+			},
+			out: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid JSON",
+			in: func(t *testing.T) *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader("{"))
+				req.Header.Set(signatureHeader, Signature(secret, []byte("{")))
+				return req
+			},
+			out: http.StatusBadRequest,
+		},
+		{
+			name: "too large",
+			in: func(t *testing.T) *http.Request {
+				return newSignedRequest(t, "testdata/handler/time_entry_created.json")
+			},
+			options: []HandlerOption{WithMaxBodyBytes(16)},
+			out:     http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "event func error",
+			in: func(t *testing.T) *http.Request {
+				return newSignedRequest(t, "testdata/handler/time_entry_created.json")
+			},
+			options: []HandlerOption{WithEventFunc(func(ctx context.Context, event *Event) error {
+				return errors.New("database is down")
+			})},
+			out: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handledErr error
+			options := append(tt.options, WithErrorFunc(func(r *http.Request, err error) {
+				handledErr = err
+			}))
+			handler := NewHandler(secret, options...)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, tt.in(t))
+
+			if rec.Code != tt.out {
+				internal.Errorf(t, rec.Code, tt.out)
+			}
+			if handledErr == nil {
+				t.Error("error func was not called")
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package webhooks
+
+import (
+	"context"
+	"sync"
+)
+
+// Router dispatches events to the functions registered for their entities and actions.
+// Its HandleEvent can be passed to WithEventFunc.
+// It is safe for concurrent use by multiple goroutines.
+type Router struct {
+	mu       sync.RWMutex
+	routes   map[route]func(ctx context.Context, event *Event) error
+	fallback func(ctx context.Context, event *Event) error
+}
+
+type route struct {
+	entity Entity
+	action Action
+}
+
+// NewRouter creates a new Router which has no routes.
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[route]func(ctx context.Context, event *Event) error),
+	}
+}
+
+// On registers the function for the events of the entity and the action, replacing the previous one.
+// Either of them can be EntityAll or ActionAll to match any entity or action.
+func (r *Router) On(entity Entity, action Action, f func(ctx context.Context, event *Event) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes[route{entity: entity, action: action}] = f
+}
+
+// OnFallback registers the function for the events which match no routes.
+func (r *Router) OnFallback(f func(ctx context.Context, event *Event) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = f
+}
+
+// HandleEvent calls the function of the most specific route which matches the event.
+// The exact entity and action take precedence over EntityAll and ActionAll, and the entity over the action.
+// If no routes match, the fallback is called, or the event is ignored if there's no fallback.
+func (r *Router) HandleEvent(ctx context.Context, event *Event) error {
+	if f := r.match(event.Entity(), event.Action()); f != nil {
+		return f(ctx, event)
+	}
+	return nil
+}
+
+func (r *Router) match(entity Entity, action Action) func(ctx context.Context, event *Event) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	candidates := []route{
+		{entity: entity, action: action},
+		{entity: entity, action: ActionAll},
+		{entity: EntityAll, action: action},
+		{entity: EntityAll, action: ActionAll},
+	}
+	for _, candidate := range candidates {
+		if f, ok := r.routes[candidate]; ok {
+			return f
+		}
+	}
+	return r.fallback
+}
+
+func on[T any](r *Router, entity Entity, action Action, decode func(*Event) (*T, error), f func(ctx context.Context, event *T) error) {
+	r.On(entity, action, func(ctx context.Context, event *Event) error {
+		typedEvent, err := decode(event)
+		if err != nil {
+			return err
+		}
+		return f(ctx, typedEvent)
+	})
+}
+
+// OnClient registers the function for any event of a client.
+func (r *Router) OnClient(f func(ctx context.Context, event *ClientEvent) error) {
+	on(r, EntityClient, ActionAll, DecodeClientEvent, f)
+}
+
+// OnClientCreated registers the function for the events of a client being created.
+func (r *Router) OnClientCreated(f func(ctx context.Context, event *ClientEvent) error) {
+	on(r, EntityClient, ActionCreated, DecodeClientEvent, f)
+}
+
+// OnClientUpdated registers the function for the events of a client being updated.
+func (r *Router) OnClientUpdated(f func(ctx context.Context, event *ClientEvent) error) {
+	on(r, EntityClient, ActionUpdated, DecodeClientEvent, f)
+}
+
+// OnClientDeleted registers the function for the events of a client being deleted.
+func (r *Router) OnClientDeleted(f func(ctx context.Context, event *ClientEvent) error) {
+	on(r, EntityClient, ActionDeleted, DecodeClientEvent, f)
+}
+
+// OnProject registers the function for any event of a project.
+func (r *Router) OnProject(f func(ctx context.Context, event *ProjectEvent) error) {
+	on(r, EntityProject, ActionAll, DecodeProjectEvent, f)
+}
+
+// OnProjectCreated registers the function for the events of a project being created.
+func (r *Router) OnProjectCreated(f func(ctx context.Context, event *ProjectEvent) error) {
+	on(r, EntityProject, ActionCreated, DecodeProjectEvent, f)
+}
+
+// OnProjectUpdated registers the function for the events of a project being updated.
+func (r *Router) OnProjectUpdated(f func(ctx context.Context, event *ProjectEvent) error) {
+	on(r, EntityProject, ActionUpdated, DecodeProjectEvent, f)
+}
+
+// OnProjectDeleted registers the function for the events of a project being deleted.
+func (r *Router) OnProjectDeleted(f func(ctx context.Context, event *ProjectEvent) error) {
+	on(r, EntityProject, ActionDeleted, DecodeProjectEvent, f)
+}
+
+// OnProjectGroup registers the function for any event of a group assigned to a project.
+func (r *Router) OnProjectGroup(f func(ctx context.Context, event *ProjectGroupEvent) error) {
+	on(r, EntityProjectGroup, ActionAll, DecodeProjectGroupEvent, f)
+}
+
+// OnProjectGroupCreated registers the function for the events of a group assigned to a project being created.
+func (r *Router) OnProjectGroupCreated(f func(ctx context.Context, event *ProjectGroupEvent) error) {
+	on(r, EntityProjectGroup, ActionCreated, DecodeProjectGroupEvent, f)
+}
+
+// OnProjectGroupUpdated registers the function for the events of a group assigned to a project being updated.
+func (r *Router) OnProjectGroupUpdated(f func(ctx context.Context, event *ProjectGroupEvent) error) {
+	on(r, EntityProjectGroup, ActionUpdated, DecodeProjectGroupEvent, f)
+}
+
+// OnProjectGroupDeleted registers the function for the events of a group assigned to a project being deleted.
+func (r *Router) OnProjectGroupDeleted(f func(ctx context.Context, event *ProjectGroupEvent) error) {
+	on(r, EntityProjectGroup, ActionDeleted, DecodeProjectGroupEvent, f)
+}
+
+// OnProjectUser registers the function for any event of a project user.
+func (r *Router) OnProjectUser(f func(ctx context.Context, event *ProjectUserEvent) error) {
+	on(r, EntityProjectUser, ActionAll, DecodeProjectUserEvent, f)
+}
+
+// OnProjectUserCreated registers the function for the events of a project user being created.
+func (r *Router) OnProjectUserCreated(f func(ctx context.Context, event *ProjectUserEvent) error) {
+	on(r, EntityProjectUser, ActionCreated, DecodeProjectUserEvent, f)
+}
+
+// OnProjectUserUpdated registers the function for the events of a project user being updated.
+func (r *Router) OnProjectUserUpdated(f func(ctx context.Context, event *ProjectUserEvent) error) {
+	on(r, EntityProjectUser, ActionUpdated, DecodeProjectUserEvent, f)
+}
+
+// OnProjectUserDeleted registers the function for the events of a project user being deleted.
+func (r *Router) OnProjectUserDeleted(f func(ctx context.Context, event *ProjectUserEvent) error) {
+	on(r, EntityProjectUser, ActionDeleted, DecodeProjectUserEvent, f)
+}
+
+// OnTag registers the function for any event of a tag.
+func (r *Router) OnTag(f func(ctx context.Context, event *TagEvent) error) {
+	on(r, EntityTag, ActionAll, DecodeTagEvent, f)
+}
+
+// OnTagCreated registers the function for the events of a tag being created.
+func (r *Router) OnTagCreated(f func(ctx context.Context, event *TagEvent) error) {
+	on(r, EntityTag, ActionCreated, DecodeTagEvent, f)
+}
+
+// OnTagUpdated registers the function for the events of a tag being updated.
+func (r *Router) OnTagUpdated(f func(ctx context.Context, event *TagEvent) error) {
+	on(r, EntityTag, ActionUpdated, DecodeTagEvent, f)
+}
+
+// OnTagDeleted registers the function for the events of a tag being deleted.
+func (r *Router) OnTagDeleted(f func(ctx context.Context, event *TagEvent) error) {
+	on(r, EntityTag, ActionDeleted, DecodeTagEvent, f)
+}
+
+// OnTask registers the function for any event of a task.
+func (r *Router) OnTask(f func(ctx context.Context, event *TaskEvent) error) {
+	on(r, EntityTask, ActionAll, DecodeTaskEvent, f)
+}
+
+// OnTaskCreated registers the function for the events of a task being created.
+func (r *Router) OnTaskCreated(f func(ctx context.Context, event *TaskEvent) error) {
+	on(r, EntityTask, ActionCreated, DecodeTaskEvent, f)
+}
+
+// OnTaskUpdated registers the function for the events of a task being updated.
+func (r *Router) OnTaskUpdated(f func(ctx context.Context, event *TaskEvent) error) {
+	on(r, EntityTask, ActionUpdated, DecodeTaskEvent, f)
+}
+
+// OnTaskDeleted registers the function for the events of a task being deleted.
+func (r *Router) OnTaskDeleted(f func(ctx context.Context, event *TaskEvent) error) {
+	on(r, EntityTask, ActionDeleted, DecodeTaskEvent, f)
+}
+
+// OnTimeEntry registers the function for any event of a time entry.
+func (r *Router) OnTimeEntry(f func(ctx context.Context, event *TimeEntryEvent) error) {
+	on(r, EntityTimeEntry, ActionAll, DecodeTimeEntryEvent, f)
+}
+
+// OnTimeEntryCreated registers the function for the events of a time entry being created.
+func (r *Router) OnTimeEntryCreated(f func(ctx context.Context, event *TimeEntryEvent) error) {
+	on(r, EntityTimeEntry, ActionCreated, DecodeTimeEntryEvent, f)
+}
+
+// OnTimeEntryUpdated registers the function for the events of a time entry being updated.
+func (r *Router) OnTimeEntryUpdated(f func(ctx context.Context, event *TimeEntryEvent) error) {
+	on(r, EntityTimeEntry, ActionUpdated, DecodeTimeEntryEvent, f)
+}
+
+// OnTimeEntryDeleted registers the function for the events of a time entry being deleted.
+func (r *Router) OnTimeEntryDeleted(f func(ctx context.Context, event *TimeEntryEvent) error) {
+	on(r, EntityTimeEntry, ActionDeleted, DecodeTimeEntryEvent, f)
+}
+
+// OnWorkspace registers the function for any event of a workspace.
+func (r *Router) OnWorkspace(f func(ctx context.Context, event *WorkspaceEvent) error) {
+	on(r, EntityWorkspace, ActionAll, DecodeWorkspaceEvent, f)
+}
+
+// OnWorkspaceCreated registers the function for the events of a workspace being created.
+func (r *Router) OnWorkspaceCreated(f func(ctx context.Context, event *WorkspaceEvent) error) {
+	on(r, EntityWorkspace, ActionCreated, DecodeWorkspaceEvent, f)
+}
+
+// OnWorkspaceUpdated registers the function for the events of a workspace being updated.
+func (r *Router) OnWorkspaceUpdated(f func(ctx context.Context, event *WorkspaceEvent) error) {
+	on(r, EntityWorkspace, ActionUpdated, DecodeWorkspaceEvent, f)
+}
+
+// OnWorkspaceDeleted registers the function for the events of a workspace being deleted.
+func (r *Router) OnWorkspaceDeleted(f func(ctx context.Context, event *WorkspaceEvent) error) {
+	on(r, EntityWorkspace, ActionDeleted, DecodeWorkspaceEvent, f)
+}
+
+// OnWorkspaceUser registers the function for any event of a workspace user.
+func (r *Router) OnWorkspaceUser(f func(ctx context.Context, event *WorkspaceUserEvent) error) {
+	on(r, EntityWorkspaceUser, ActionAll, DecodeWorkspaceUserEvent, f)
+}
+
+// OnWorkspaceUserCreated registers the function for the events of a workspace user being created.
+func (r *Router) OnWorkspaceUserCreated(f func(ctx context.Context, event *WorkspaceUserEvent) error) {
+	on(r, EntityWorkspaceUser, ActionCreated, DecodeWorkspaceUserEvent, f)
+}
+
+// OnWorkspaceUserUpdated registers the function for the events of a workspace user being updated.
+func (r *Router) OnWorkspaceUserUpdated(f func(ctx context.Context, event *WorkspaceUserEvent) error) {
+	on(r, EntityWorkspaceUser, ActionUpdated, DecodeWorkspaceUserEvent, f)
+}
+
+// OnWorkspaceUserDeleted registers the function for the events of a workspace user being deleted.
+func (r *Router) OnWorkspaceUserDeleted(f func(ctx context.Context, event *WorkspaceUserEvent) error) {
+	on(r, EntityWorkspaceUser, ActionDeleted, DecodeWorkspaceUserEvent, f)
+}
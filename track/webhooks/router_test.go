@@ -0,0 +1,235 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+	"github.com/ta9mi141/toggl-go/track/toggl"
+)
+
+func readEvent(t *testing.T, testdataFile string) *Event {
+	body, err := os.ReadFile(testdataFile)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	event := new(Event)
+	if err := json.Unmarshal(body, event); err != nil {
+		t.Fatal(err.Error())
+	}
+	return event
+}
+
+func TestRouterDecodesEachEntity(t *testing.T) {
+	var got any
+	router := NewRouter()
+	router.OnClientCreated(func(ctx context.Context, event *ClientEvent) error {
+		got = event.Client
+		return nil
+	})
+	router.OnProjectUpdated(func(ctx context.Context, event *ProjectEvent) error {
+		got = event.Project
+		return nil
+	})
+	router.OnProjectGroupCreated(func(ctx context.Context, event *ProjectGroupEvent) error {
+		got = event.ProjectGroup
+		return nil
+	})
+	router.OnProjectUserDeleted(func(ctx context.Context, event *ProjectUserEvent) error {
+		got = event.ProjectUser
+		return nil
+	})
+	router.OnTagCreated(func(ctx context.Context, event *TagEvent) error {
+		got = event.Tag
+		return nil
+	})
+	router.OnTaskUpdated(func(ctx context.Context, event *TaskEvent) error {
+		got = event.Task
+		return nil
+	})
+	router.OnTimeEntryCreated(func(ctx context.Context, event *TimeEntryEvent) error {
+		got = event.TimeEntry
+		return nil
+	})
+	router.OnWorkspaceUpdated(func(ctx context.Context, event *WorkspaceEvent) error {
+		got = event.Workspace
+		return nil
+	})
+	router.OnWorkspaceUserDeleted(func(ctx context.Context, event *WorkspaceUserEvent) error {
+		got = event.WorkspaceUser
+		return nil
+	})
+	router.OnFallback(func(ctx context.Context, event *Event) error {
+		return errors.New("event was not routed")
+	})
+
+	tests := []struct {
+		name string
+		in   string
+		out  any
+	}{
+		{
+			name: "client created",
+			in:   "testdata/events/client_created.json",
+			out:  &toggl.Client{ID: track.Ptr(4567890), WID: track.Ptr(2345678), Name: track.Ptr("MyClient"), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)), Archived: track.Ptr(false), Notes: track.Ptr("MyNotes"), CreatorID: track.Ptr(3456789)},
+		},
+		{
+			name: "project updated",
+			in:   "testdata/events/project_updated.json",
+			out:  &toggl.Project{ID: track.Ptr(4567890), WorkspaceID: track.Ptr(2345678), ClientID: track.Ptr(5678901), Name: track.Ptr("MyProject"), IsPrivate: track.Ptr(true), Active: track.Ptr(true), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)), Color: track.Ptr("#0b83d9"), Billable: track.Ptr(false)},
+		},
+		{
+			name: "project_group created",
+			in:   "testdata/events/project_group_created.json",
+			out:  &ProjectGroup{ID: track.Ptr(4567890), ProjectID: track.Ptr(5678901), GroupID: track.Ptr(6789012), WorkspaceID: track.Ptr(2345678), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC))},
+		},
+		{
+			name: "project_user deleted",
+			in:   "testdata/events/project_user_deleted.json",
+			out:  &toggl.ProjectUser{ID: track.Ptr(4567890), ProjectID: track.Ptr(5678901), UserID: track.Ptr(3456789), WorkspaceID: track.Ptr(2345678), Manager: track.Ptr(false), Rate: track.Ptr(1000), LaborCost: track.Ptr(500), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC))},
+		},
+		{
+			name: "tag created",
+			in:   "testdata/events/tag_created.json",
+			out:  &toggl.Tag{ID: track.Ptr(4567890), WorkspaceID: track.Ptr(2345678), Name: track.Ptr("MyTag"), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC))},
+		},
+		{
+			name: "task updated",
+			in:   "testdata/events/task_updated.json",
+			out:  &toggl.Task{ID: track.Ptr(4567890), Name: track.Ptr("MyTask"), WorkspaceID: track.Ptr(2345678), ProjectID: track.Ptr(5678901), UserID: track.Ptr(3456789), Active: track.Ptr(true), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)), EstimatedSeconds: track.Ptr(3600)},
+		},
+		{
+			name: "time_entry created",
+			in:   "testdata/events/time_entry_created.json",
+			out:  &toggl.TimeEntry{ID: track.Ptr(4567890), WorkspaceID: track.Ptr(2345678), ProjectID: track.Ptr(5678901), Billable: track.Ptr(false), Start: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)), Duration: track.Ptr(-1641092645), Description: track.Ptr("MyTimeEntry"), Tags: []*string{track.Ptr("MyTag")}, TagIDs: []*int{track.Ptr(6789012)}, UserID: track.Ptr(3456789)},
+		},
+		{
+			name: "workspace updated",
+			in:   "testdata/events/workspace_updated.json",
+			out:  &toggl.Workspace{ID: track.Ptr(2345678), OrganizationID: track.Ptr(1234567), Name: track.Ptr("MyWorkspace"), Premium: track.Ptr(false), Admin: track.Ptr(true), DefaultHourlyRate: track.Ptr(1000), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC))},
+		},
+		{
+			name: "workspace_user deleted",
+			in:   "testdata/events/workspace_user_deleted.json",
+			out:  &toggl.WorkspaceUser{ID: track.Ptr(4567890), UserID: track.Ptr(3456789), WorkspaceID: track.Ptr(2345678), Admin: track.Ptr(false), Active: track.Ptr(true), Email: track.Ptr("user@example.com"), At: track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)), Name: track.Ptr("MyUser")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			if err := router.HandleEvent(context.Background(), readEvent(t, tt.in)); err != nil {
+				t.Fatal(err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.out) {
+				internal.Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
+
+func TestRouterPrecedence(t *testing.T) {
+	newEvent := func(entity Entity, action Action) *Event {
+		return &Event{Metadata: &EventMetadata{Model: track.Ptr(string(entity)), Action: track.Ptr(string(action))}}
+	}
+	tests := []struct {
+		name string
+		in   *Event
+		out  string
+	}{
+		{name: "exact", in: newEvent(EntityTag, ActionCreated), out: "tag created"},
+		{name: "any action of entity", in: newEvent(EntityTag, ActionDeleted), out: "tag *"},
+		{name: "action of any entity", in: newEvent(EntityClient, ActionCreated), out: "* created"},
+		{name: "any", in: newEvent(EntityClient, ActionUpdated), out: "* *"},
+		{name: "no metadata", in: &Event{}, out: "* *"},
+	}
+	router := NewRouter()
+	var got string
+	for _, r := range []struct {
+		entity Entity
+		action Action
+	}{{EntityTag, ActionCreated}, {EntityTag, ActionAll}, {EntityAll, ActionCreated}, {EntityAll, ActionAll}} {
+		router.On(r.entity, r.action, func(ctx context.Context, event *Event) error {
+			got = string(r.entity) + " " + string(r.action)
+			return nil
+		})
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = ""
+			if err := router.HandleEvent(context.Background(), tt.in); err != nil {
+				t.Fatal(err.Error())
+			}
+			if got != tt.out {
+				internal.Errorf(t, got, tt.out)
+			}
+		})
+	}
+}
+
+func TestRouterFallback(t *testing.T) {
+	event := readEvent(t, "testdata/events/tag_created.json")
+
+	router := NewRouter()
+	router.OnTimeEntry(func(ctx context.Context, event *TimeEntryEvent) error {
+		t.Error("route of another entity was called")
+		return nil
+	})
+	if err := router.HandleEvent(context.Background(), event); err != nil {
+		internal.Errorf(t, err, nil)
+	}
+
+	var fallback *Event
+	router.OnFallback(func(ctx context.Context, event *Event) error {
+		fallback = event
+		return nil
+	})
+	if err := router.HandleEvent(context.Background(), event); err != nil {
+		t.Fatal(err.Error())
+	}
+	if fallback != event {
+		internal.Errorf(t, fallback, event)
+	}
+}
+
+func TestRouterWithInvalidPayload(t *testing.T) {
+	event := readEvent(t, "testdata/events/tag_created.json")
+	event.Payload = json.RawMessage(`"tag"`)
+
+	router := NewRouter()
+	router.OnTagCreated(func(ctx context.Context, event *TagEvent) error {
+		t.Error("route was called with invalid payload")
+		return nil
+	})
+	err := router.HandleEvent(context.Background(), event)
+	if err == nil || !strings.Contains(err.Error(), "failed to decode the payload of tag") {
+		internal.Errorf(t, err, "failed to decode the payload of tag")
+	}
+}
+
+func TestHandlerWithRouter(t *testing.T) {
+	var got *toggl.TimeEntry
+	router := NewRouter()
+	router.OnTimeEntryCreated(func(ctx context.Context, event *TimeEntryEvent) error {
+		got = event.TimeEntry
+		return nil
+	})
+	handler := NewHandler(secret, WithEventFunc(router.HandleEvent))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, "testdata/events/time_entry_created.json"))
+
+	if rec.Code != http.StatusOK {
+		internal.Errorf(t, rec.Code, http.StatusOK)
+	}
+	if got == nil || *got.Description != "MyTimeEntry" {
+		internal.Errorf(t, got, "MyTimeEntry")
+	}
+}
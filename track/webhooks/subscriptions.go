@@ -0,0 +1,210 @@
+package webhooks
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+// Subscription represents the properties of a webhook subscription.
+type Subscription struct {
+	SubscriptionID   *int           `json:"subscription_id,omitempty"`
+	WorkspaceID      *int           `json:"workspace_id,omitempty"`
+	UserID           *int           `json:"user_id,omitempty"`
+	Enabled          *bool          `json:"enabled,omitempty"`
+	Description      *string        `json:"description,omitempty"`
+	EventFilters     []*EventFilter `json:"event_filters,omitempty"`
+	URLCallback      *string        `json:"url_callback,omitempty"`
+	Secret           *string        `json:"secret,omitempty"`
+	ValidatedAt      *time.Time     `json:"validated_at,omitempty"`
+	HasPendingEvents *bool          `json:"has_pending_events,omitempty"`
+	CreatedAt        *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time     `json:"updated_at,omitempty"`
+	DeletedAt        *time.Time     `json:"deleted_at,omitempty"`
+}
+
+// SubscriptionStatus describes whether a subscription is receiving events.
+// Toggl doesn't document an endpoint which lists failed deliveries, so it's derived from the fields of Subscription.
+type SubscriptionStatus string
+
+const (
+	// SubscriptionStatusActive means that the subscription is receiving events.
+	SubscriptionStatusActive SubscriptionStatus = "active"
+	// SubscriptionStatusDisabled means that the subscription is disabled by a user or by Toggl.
+	SubscriptionStatusDisabled SubscriptionStatus = "disabled"
+	// SubscriptionStatusNotValidated means that the URL callback hasn't been validated by ValidateURL yet.
+	SubscriptionStatusNotValidated SubscriptionStatus = "not_validated"
+	// SubscriptionStatusPending means that some events haven't been delivered to the URL callback yet,
+	// which usually means that the deliveries are failing and being retried.
+	SubscriptionStatusPending SubscriptionStatus = "pending"
+)
+
+// Status returns the status of the subscription.
+func (s *Subscription) Status() SubscriptionStatus {
+	switch {
+	case s.Enabled == nil || !*s.Enabled:
+		return SubscriptionStatusDisabled
+	case s.ValidatedAt == nil:
+		return SubscriptionStatusNotValidated
+	case s.HasPendingEvents != nil && *s.HasPendingEvents:
+		return SubscriptionStatusPending
+	}
+	return SubscriptionStatusActive
+}
+
+// EventFilter represents a pair of an entity and an action which a subscription is notified of.
+// Entity and Action take the values of Entity and Action constants, and EventFilterBuilder builds them type-safely.
+type EventFilter struct {
+	Entity *string `json:"entity,omitempty"`
+	Action *string `json:"action,omitempty"`
+}
+
+// ListSubscriptions lists subscriptions for given workspace.
+func (c *APIClient) ListSubscriptions(ctx context.Context, workspaceID int) ([]*Subscription, error) {
+	var subscriptions []*Subscription
+	apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID))
+	if err := c.httpGet(ctx, apiSpecificPath, nil, &subscriptions); err != nil {
+		return nil, errors.Wrap(err, "failed to list subscriptions")
+	}
+	return subscriptions, nil
+}
+
+// GetSubscriptionStatus gets the status of a subscription for given workspace.
+// It lists the subscriptions of the workspace, since there is no endpoint to get a single subscription.
+func (c *APIClient) GetSubscriptionStatus(ctx context.Context, workspaceID, subscriptionID int) (SubscriptionStatus, error) {
+	subscriptions, err := c.ListSubscriptions(ctx, workspaceID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get subscription status")
+	}
+	for _, subscription := range subscriptions {
+		if subscription.SubscriptionID != nil && *subscription.SubscriptionID == subscriptionID {
+			return subscription.Status(), nil
+		}
+	}
+	return "", errors.Errorf("failed to get subscription status: subscription %d is not found", subscriptionID)
+}
+
+// CreateSubscriptionRequestBody represents a request body of CreateSubscription.
+type CreateSubscriptionRequestBody struct {
+	Description  *string        `json:"description,omitempty"`
+	Enabled      *bool          `json:"enabled,omitempty"`
+	EventFilters []*EventFilter `json:"event_filters,omitempty"`
+	Secret       *string        `json:"secret,omitempty"`
+	URLCallback  *string        `json:"url_callback,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *CreateSubscriptionRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.URLCallback, "url_callback")
+	v.Required(r.EventFilters, "event_filters")
+	return v.Err()
+}
+
+// CreateSubscription creates a new subscription for given workspace.
+func (c *APIClient) CreateSubscription(ctx context.Context, workspaceID int, reqBody *CreateSubscriptionRequestBody) (*Subscription, error) {
+	var subscription *Subscription
+	apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID))
+	if err := c.httpPost(ctx, apiSpecificPath, reqBody, &subscription); err != nil {
+		return nil, errors.Wrap(err, "failed to create subscription")
+	}
+	return subscription, nil
+}
+
+// UpdateSubscriptionRequestBody represents a request body of UpdateSubscription.
+type UpdateSubscriptionRequestBody struct {
+	Description  *string        `json:"description,omitempty"`
+	Enabled      *bool          `json:"enabled,omitempty"`
+	EventFilters []*EventFilter `json:"event_filters,omitempty"`
+	Secret       *string        `json:"secret,omitempty"`
+	URLCallback  *string        `json:"url_callback,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *UpdateSubscriptionRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.URLCallback, "url_callback")
+	v.Required(r.EventFilters, "event_filters")
+	return v.Err()
+}
+
+// UpdateSubscription updates a subscription for given workspace.
+func (c *APIClient) UpdateSubscription(ctx context.Context, workspaceID, subscriptionID int, reqBody *UpdateSubscriptionRequestBody) (*Subscription, error) {
+	var subscription *Subscription
+	apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+	if err := c.httpPut(ctx, apiSpecificPath, reqBody, &subscription); err != nil {
+		return nil, errors.Wrap(err, "failed to update subscription")
+	}
+	return subscription, nil
+}
+
+// PatchSubscriptionRequestBody represents a request body of PatchSubscription.
+type PatchSubscriptionRequestBody struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// Validate returns a *track.ValidationError if the request body has invalid fields.
+func (r *PatchSubscriptionRequestBody) Validate() error {
+	v := internal.NewValidator(r)
+	v.Required(r.Enabled, "enabled")
+	return v.Err()
+}
+
+// PatchSubscription enables or disables a subscription for given workspace.
+func (c *APIClient) PatchSubscription(ctx context.Context, workspaceID, subscriptionID int, reqBody *PatchSubscriptionRequestBody) (*Subscription, error) {
+	var subscription *Subscription
+	apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+	if err := c.httpPatch(ctx, apiSpecificPath, reqBody, &subscription); err != nil {
+		return nil, errors.Wrap(err, "failed to patch subscription")
+	}
+	return subscription, nil
+}
+
+// DeleteSubscription deletes a subscription for given workspace.
+func (c *APIClient) DeleteSubscription(ctx context.Context, workspaceID, subscriptionID int) error {
+	apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+	if err := c.httpDelete(ctx, apiSpecificPath); err != nil {
+		return errors.Wrap(err, "failed to delete subscription")
+	}
+	return nil
+}
+
+// Ping sends a ping event to the URL callback of a subscription.
+func (c *APIClient) Ping(ctx context.Context, workspaceID, subscriptionID int) error {
+	apiSpecificPath := path.Join(webhooksPath, "ping", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+	if err := c.httpPost(ctx, apiSpecificPath, nil, nil); err != nil {
+		return errors.Wrap(err, "failed to ping")
+	}
+	return nil
+}
+
+// ValidateURL validates the URL callback of a subscription with the validation code sent to it.
+// It returns a *track.ValidationError without sending a request if the code isn't a single path segment.
+func (c *APIClient) ValidateURL(ctx context.Context, workspaceID, subscriptionID int, validationCode string) error {
+	if validationCode == "" || validationCode == "." || validationCode == ".." || strings.ContainsAny(validationCode, "/\\") {
+		return errors.Wrap(&track.ValidationError{
+			Fields: []*track.FieldError{{Field: "validation_code", Message: "must be a single path segment"}},
+		}, "failed to validate URL")
+	}
+	apiSpecificPath := path.Join(webhooksPath, "validate", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID), validationCode)
+	if err := c.httpGet(ctx, apiSpecificPath, nil, nil); err != nil {
+		return errors.Wrap(err, "failed to validate URL")
+	}
+	return nil
+}
+
+// GetSubscriptionLimits gets the maximum number of subscriptions for given workspace.
+func (c *APIClient) GetSubscriptionLimits(ctx context.Context, workspaceID int) (int, error) {
+	var limits int
+	apiSpecificPath := path.Join(webhooksPath, "limits", strconv.Itoa(workspaceID))
+	if err := c.httpGet(ctx, apiSpecificPath, nil, &limits); err != nil {
+		return 0, errors.Wrap(err, "failed to get subscription limits")
+	}
+	return limits, nil
+}
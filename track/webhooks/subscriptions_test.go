@@ -0,0 +1,1287 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ta9mi141/toggl-go/track"
+	"github.com/ta9mi141/toggl-go/track/internal"
+)
+
+func TestListSubscriptions(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			subscriptions []*Subscription
+			err           error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/list_subscriptions_200_ok.json",
+			},
+			out: struct {
+				subscriptions []*Subscription
+				err           error
+			}{
+				subscriptions: []*Subscription{
+					&Subscription{
+						SubscriptionID:   track.Ptr(5678901),
+						WorkspaceID:      track.Ptr(2345678),
+						UserID:           track.Ptr(3456789),
+						Enabled:          track.Ptr(true),
+						Description:      track.Ptr("MySubscription"),
+						EventFilters:     []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}},
+						URLCallback:      track.Ptr("https://example.com/webhooks"),
+						Secret:           track.Ptr("secret"),
+						ValidatedAt:      track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+						HasPendingEvents: track.Ptr(false),
+						CreatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+						UpdatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/list_subscriptions_400_bad_request.json",
+			},
+			out: struct {
+				subscriptions []*Subscription
+				err           error
+			}{
+				subscriptions: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/list_subscriptions_401_unauthorized",
+			},
+			out: struct {
+				subscriptions []*Subscription
+				err           error
+			}{
+				subscriptions: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/list_subscriptions_403_forbidden",
+			},
+			out: struct {
+				subscriptions []*Subscription
+				err           error
+			}{
+				subscriptions: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			subscriptions, err := apiClient.ListSubscriptions(context.Background(), workspaceID)
+
+			if !reflect.DeepEqual(subscriptions, tt.out.subscriptions) {
+				internal.Errorf(t, subscriptions, tt.out.subscriptions)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestSubscriptionStatus(t *testing.T) {
+	validatedAt := track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC))
+	tests := []struct {
+		name string
+		in   *Subscription
+		out  SubscriptionStatus
+	}{
+		{
+			name: "active",
+			in:   &Subscription{Enabled: track.Ptr(true), ValidatedAt: validatedAt, HasPendingEvents: track.Ptr(false)},
+			out:  SubscriptionStatusActive,
+		},
+		{
+			name: "disabled",
+			in:   &Subscription{Enabled: track.Ptr(false), ValidatedAt: validatedAt, HasPendingEvents: track.Ptr(true)},
+			out:  SubscriptionStatusDisabled,
+		},
+		{
+			name: "not validated",
+			in:   &Subscription{Enabled: track.Ptr(true), HasPendingEvents: track.Ptr(true)},
+			out:  SubscriptionStatusNotValidated,
+		},
+		{
+			name: "pending",
+			in:   &Subscription{Enabled: track.Ptr(true), ValidatedAt: validatedAt, HasPendingEvents: track.Ptr(true)},
+			out:  SubscriptionStatusPending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := tt.in.Status(); status != tt.out {
+				internal.Errorf(t, status, tt.out)
+			}
+		})
+	}
+}
+
+func TestGetSubscriptionStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		in   int
+		out  struct {
+			status SubscriptionStatus
+			err    string
+		}
+	}{
+		{
+			name: "found",
+			in:   5678901,
+			out: struct {
+				status SubscriptionStatus
+				err    string
+			}{
+				status: SubscriptionStatusActive,
+				err:    "",
+			},
+		},
+		{
+			name: "not found",
+			in:   6789012,
+			out: struct {
+				status SubscriptionStatus
+				err    string
+			}{
+				status: "",
+				err:    "failed to get subscription status: subscription 6789012 is not found",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, http.StatusOK, "testdata/subscriptions/list_subscriptions_200_ok.json")
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			status, err := apiClient.GetSubscriptionStatus(context.Background(), workspaceID, tt.in)
+
+			if status != tt.out.status {
+				internal.Errorf(t, status, tt.out.status)
+			}
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.out.err {
+				internal.Errorf(t, got, tt.out.err)
+			}
+		})
+	}
+}
+
+func TestCreateSubscription(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			subscription *Subscription
+			err          error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/create_subscription_200_ok.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: &Subscription{
+					SubscriptionID:   track.Ptr(5678901),
+					WorkspaceID:      track.Ptr(2345678),
+					UserID:           track.Ptr(3456789),
+					Enabled:          track.Ptr(true),
+					Description:      track.Ptr("MySubscription"),
+					EventFilters:     []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}},
+					URLCallback:      track.Ptr("https://example.com/webhooks"),
+					Secret:           track.Ptr("secret"),
+					ValidatedAt:      track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					HasPendingEvents: track.Ptr(false),
+					CreatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					UpdatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/create_subscription_400_bad_request.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/create_subscription_401_unauthorized",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/create_subscription_403_forbidden",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			subscription, err := apiClient.CreateSubscription(context.Background(), workspaceID, &CreateSubscriptionRequestBody{URLCallback: track.Ptr("https://example.com/webhooks"), EventFilters: []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}}})
+
+			if !reflect.DeepEqual(subscription, tt.out.subscription) {
+				internal.Errorf(t, subscription, tt.out.subscription)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateSubscriptionRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *CreateSubscriptionRequestBody
+		out  string
+	}{
+		{
+			name: "required fields",
+			in:   &CreateSubscriptionRequestBody{URLCallback: track.Ptr("https://example.com/webhooks"), EventFilters: []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}}},
+			out:  "{\"event_filters\":[{\"entity\":\"time_entry\",\"action\":\"created\"}],\"url_callback\":\"https://example.com/webhooks\"}",
+		},
+		{
+			name: "all fields",
+			in: &CreateSubscriptionRequestBody{
+				Description:  track.Ptr("MySubscription"),
+				Enabled:      track.Ptr(true),
+				EventFilters: []*EventFilter{{Entity: track.Ptr("project"), Action: track.Ptr("*")}},
+				Secret:       track.Ptr("secret"),
+				URLCallback:  track.Ptr("https://example.com/webhooks"),
+			},
+			out: "{\"description\":\"MySubscription\",\"enabled\":true,\"event_filters\":[{\"entity\":\"project\",\"action\":\"*\"}],\"secret\":\"secret\",\"url_callback\":\"https://example.com/webhooks\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			workspaceID := 2345678
+			_, _ = apiClient.CreateSubscription(context.Background(), workspaceID, tt.in)
+		})
+	}
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			subscription *Subscription
+			err          error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/update_subscription_200_ok.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: &Subscription{
+					SubscriptionID:   track.Ptr(5678901),
+					WorkspaceID:      track.Ptr(2345678),
+					UserID:           track.Ptr(3456789),
+					Enabled:          track.Ptr(true),
+					Description:      track.Ptr("MySubscription"),
+					EventFilters:     []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}},
+					URLCallback:      track.Ptr("https://example.com/webhooks"),
+					Secret:           track.Ptr("secret"),
+					ValidatedAt:      track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					HasPendingEvents: track.Ptr(false),
+					CreatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					UpdatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/update_subscription_400_bad_request.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/update_subscription_401_unauthorized",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/update_subscription_403_forbidden",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/subscriptions/update_subscription_404_not_found.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Subscription not found",
+					Body:       "\"Subscription not found\"",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			subscription, err := apiClient.UpdateSubscription(context.Background(), workspaceID, subscriptionID, &UpdateSubscriptionRequestBody{URLCallback: track.Ptr("https://example.com/webhooks"), EventFilters: []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}}})
+
+			if !reflect.DeepEqual(subscription, tt.out.subscription) {
+				internal.Errorf(t, subscription, tt.out.subscription)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchSubscription(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			subscription *Subscription
+			err          error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/patch_subscription_200_ok.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: &Subscription{
+					SubscriptionID:   track.Ptr(5678901),
+					WorkspaceID:      track.Ptr(2345678),
+					UserID:           track.Ptr(3456789),
+					Enabled:          track.Ptr(false),
+					Description:      track.Ptr("MySubscription"),
+					EventFilters:     []*EventFilter{{Entity: track.Ptr("time_entry"), Action: track.Ptr("created")}},
+					URLCallback:      track.Ptr("https://example.com/webhooks"),
+					Secret:           track.Ptr("secret"),
+					ValidatedAt:      track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					HasPendingEvents: track.Ptr(false),
+					CreatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+					UpdatedAt:        track.Ptr(time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)),
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/patch_subscription_400_bad_request.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/patch_subscription_401_unauthorized",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/patch_subscription_403_forbidden",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/subscriptions/patch_subscription_404_not_found.json",
+			},
+			out: struct {
+				subscription *Subscription
+				err          error
+			}{
+				subscription: nil,
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Subscription not found",
+					Body:       "\"Subscription not found\"",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			subscription, err := apiClient.PatchSubscription(context.Background(), workspaceID, subscriptionID, &PatchSubscriptionRequestBody{Enabled: track.Ptr(false)})
+
+			if !reflect.DeepEqual(subscription, tt.out.subscription) {
+				internal.Errorf(t, subscription, tt.out.subscription)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchSubscriptionRequestBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *PatchSubscriptionRequestBody
+		out  string
+	}{
+		{
+			name: "enable",
+			in:   &PatchSubscriptionRequestBody{Enabled: track.Ptr(true)},
+			out:  "{\"enabled\":true}",
+		},
+		{
+			name: "disable",
+			in:   &PatchSubscriptionRequestBody{Enabled: track.Ptr(false)},
+			out:  "{\"enabled\":false}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := internal.NewMockServerToAssertRequestBody(t, tt.out)
+			defer mockServer.Close()
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			_, _ = apiClient.PatchSubscription(context.Background(), workspaceID, subscriptionID, tt.in)
+		})
+	}
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/delete_subscription_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/delete_subscription_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/delete_subscription_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/delete_subscription_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/subscriptions/delete_subscription_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Subscription not found",
+					Body:       "\"Subscription not found\"",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			apiSpecificPath := path.Join(webhooksPath, "subscriptions", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			err := apiClient.DeleteSubscription(context.Background(), workspaceID, subscriptionID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/ping_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/ping_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/ping_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/ping_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/subscriptions/ping_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Subscription not found",
+					Body:       "\"Subscription not found\"",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			apiSpecificPath := path.Join(webhooksPath, "ping", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			err := apiClient.Ping(context.Background(), workspaceID, subscriptionID)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			err error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/validate_url_200_ok.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/validate_url_400_bad_request.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/validate_url_401_unauthorized",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/validate_url_403_forbidden",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "404 Not Found",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusNotFound,
+				testdataFile: "testdata/subscriptions/validate_url_404_not_found.json",
+			},
+			out: struct {
+				err error
+			}{
+				err: &track.APIError{
+					StatusCode: 404,
+					Message:    "Subscription not found",
+					Body:       "\"Subscription not found\"",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			subscriptionID := 5678901
+			validationCode := "6f1b2a3c"
+			apiSpecificPath := path.Join(webhooksPath, "validate", strconv.Itoa(workspaceID), strconv.Itoa(subscriptionID), validationCode)
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			err := apiClient.ValidateURL(context.Background(), workspaceID, subscriptionID, validationCode)
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateURLInvalidCode(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "empty", in: ""},
+		{name: "parent directory", in: ".."},
+		{name: "path traversal", in: "../../../api/v9/me"},
+		{name: "slash", in: "6f1b/2a3c"},
+		{name: "backslash", in: "6f1b\\2a3c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request to %s", r.URL.Path)
+			}))
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			err := apiClient.ValidateURL(context.Background(), 2345678, 5678901, tt.in)
+			if !track.IsValidationError(err) {
+				internal.Errorf(t, err, "invalid request: validation_code: must be a single path segment")
+			}
+		})
+	}
+}
+
+func TestGetSubscriptionLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		in   struct {
+			statusCode   int
+			testdataFile string
+		}
+		out struct {
+			limits int
+			err    error
+		}
+	}{
+		{
+			name: "200 OK",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusOK,
+				testdataFile: "testdata/subscriptions/get_subscription_limits_200_ok.json",
+			},
+			out: struct {
+				limits int
+				err    error
+			}{
+				limits: 5,
+				err:    nil,
+			},
+		},
+		{
+			name: "400 Bad Request",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusBadRequest,
+				testdataFile: "testdata/subscriptions/get_subscription_limits_400_bad_request.json",
+			},
+			out: struct {
+				limits int
+				err    error
+			}{
+				limits: 0,
+				err: &track.APIError{
+					StatusCode: 400,
+					Message:    "Invalid workspace_id",
+					Body:       "\"Invalid workspace_id\"",
+				},
+			},
+		},
+		{
+			name: "401 Unauthorized",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusUnauthorized,
+				testdataFile: "testdata/subscriptions/get_subscription_limits_401_unauthorized",
+			},
+			out: struct {
+				limits int
+				err    error
+			}{
+				limits: 0,
+				err: &track.APIError{
+					StatusCode: 401,
+					Message:    "Unauthorized",
+					Body:       "",
+				},
+			},
+		},
+		{
+			name: "403 Forbidden",
+			in: struct {
+				statusCode   int
+				testdataFile string
+			}{
+				statusCode:   http.StatusForbidden,
+				testdataFile: "testdata/subscriptions/get_subscription_limits_403_forbidden",
+			},
+			out: struct {
+				limits int
+				err    error
+			}{
+				limits: 0,
+				err: &track.APIError{
+					StatusCode: 403,
+					Message:    "Forbidden",
+					Body:       "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceID := 2345678
+			apiSpecificPath := path.Join(webhooksPath, "limits", strconv.Itoa(workspaceID))
+			mockServer := internal.NewMockServer(t, apiSpecificPath, tt.in.statusCode, tt.in.testdataFile)
+			defer mockServer.Close()
+
+			apiClient := NewAPIClient(internal.APIToken, withBaseURL(mockServer.URL))
+			limits, err := apiClient.GetSubscriptionLimits(context.Background(), workspaceID)
+
+			if !reflect.DeepEqual(limits, tt.out.limits) {
+				internal.Errorf(t, limits, tt.out.limits)
+			}
+
+			apiError := new(track.APIError)
+			if errors.As(err, &apiError) {
+				if !internal.EqualAPIError(apiError, tt.out.err) {
+					internal.Errorf(t, apiError, tt.out.err)
+				}
+			} else {
+				if !reflect.DeepEqual(err, tt.out.err) {
+					internal.Errorf(t, err, tt.out.err)
+				}
+			}
+		})
+	}
+}
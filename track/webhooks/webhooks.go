@@ -1,5 +1,9 @@
 /*
 Package webhooks is a library of Toggl Webhooks API for the Go programming language.
+It also provides Handler to receive the events delivered to subscriptions.
+
+Toggl doesn't document an endpoint which lists failed deliveries,
+so the status of a subscription is derived from its fields by Subscription.Status and GetSubscriptionStatus.
 
 See API documentation for more details.
 https://developers.track.toggl.com/docs/webhooks_start
@@ -13,6 +17,7 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
@@ -21,10 +26,14 @@ const (
 )
 
 // APIClient is a client for interacting with Toggl Webhooks API.
+// It is safe for concurrent use by multiple goroutines.
 type APIClient struct {
-	baseURL    *url.URL
-	httpClient *http.Client
-	apiToken   string
+	baseURL        *url.URL
+	httpClient     *http.Client
+	retryPolicy    *track.RetryPolicy
+	rateLimiter    *track.RateLimiter
+	skipValidation bool
+	apiToken       string
 }
 
 // NewAPIClient creates a new Toggl Webhooks API client.
@@ -61,6 +70,46 @@ func (h *httpClientOption) apply(c *APIClient) {
 	c.httpClient = h.httpClient
 }
 
+// WithRetryPolicy returns a Option that specifies the policy to retry requests which failed temporarily.
+// Requests are not retried by default.
+func WithRetryPolicy(retryPolicy *track.RetryPolicy) Option {
+	return &retryPolicyOption{retryPolicy: retryPolicy}
+}
+
+type retryPolicyOption struct {
+	retryPolicy *track.RetryPolicy
+}
+
+func (r *retryPolicyOption) apply(c *APIClient) {
+	c.retryPolicy = r.retryPolicy
+}
+
+// WithRateLimiter returns a Option that specifies the rate limiter which every request waits for.
+// The same rate limiter can be shared by clients of toggl, reports, and webhooks using the same API token.
+func WithRateLimiter(rateLimiter *track.RateLimiter) Option {
+	return &rateLimiterOption{rateLimiter: rateLimiter}
+}
+
+type rateLimiterOption struct {
+	rateLimiter *track.RateLimiter
+}
+
+func (r *rateLimiterOption) apply(c *APIClient) {
+	c.rateLimiter = r.rateLimiter
+}
+
+// WithoutValidation returns a Option that disables the validation of request bodies and queries before they're sent.
+// Requests are validated by default so that invalid ones fail fast with a *track.ValidationError.
+func WithoutValidation() Option {
+	return withoutValidationOption{}
+}
+
+type withoutValidationOption struct{}
+
+func (withoutValidationOption) apply(c *APIClient) {
+	c.skipValidation = true
+}
+
 // withBaseURL makes client testable by configurable URL.
 func withBaseURL(baseURL string) Option {
 	return baseURLOption(baseURL)
@@ -81,11 +130,50 @@ func (c *APIClient) httpGet(ctx context.Context, apiSpecificPath string, query,
 	return c.do(req, respBody)
 }
 
+func (c *APIClient) httpPost(ctx context.Context, apiSpecificPath string, reqBody, respBody any) error {
+	req, err := c.newRequest(ctx, http.MethodPost, apiSpecificPath, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a new POST request")
+	}
+	return c.do(req, respBody)
+}
+
+func (c *APIClient) httpPut(ctx context.Context, apiSpecificPath string, reqBody, respBody any) error {
+	req, err := c.newRequest(ctx, http.MethodPut, apiSpecificPath, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a new PUT request")
+	}
+	return c.do(req, respBody)
+}
+
+func (c *APIClient) httpPatch(ctx context.Context, apiSpecificPath string, reqBody, respBody any) error {
+	req, err := c.newRequest(ctx, http.MethodPatch, apiSpecificPath, reqBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a new PATCH request")
+	}
+	return c.do(req, respBody)
+}
+
+func (c *APIClient) httpDelete(ctx context.Context, apiSpecificPath string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, apiSpecificPath, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a new DELETE request")
+	}
+	return c.do(req, nil)
+}
+
 func (c *APIClient) newRequest(ctx context.Context, httpMethod, apiSpecificPath string, input any) (*http.Request, error) {
-	url := c.baseURL
+	if !c.skipValidation {
+		if err := internal.Validate(input); err != nil {
+			return nil, errors.Wrap(err, "failed to validate a request")
+		}
+	}
+
+	// Copy baseURL so that concurrent requests don't share the URL.
+	url := *c.baseURL
 	url.Path = path.Join(url.Path, apiSpecificPath)
 
-	req, err := internal.NewRequest(ctx, httpMethod, url, input)
+	req, err := internal.NewRequest(ctx, httpMethod, &url, input)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create a new request")
 	}
@@ -96,5 +184,6 @@ func (c *APIClient) newRequest(ctx context.Context, httpMethod, apiSpecificPath
 }
 
 func (c *APIClient) do(req *http.Request, respBody any) error {
-	return internal.Do(c.httpClient, req, respBody)
+	_, err := internal.Do(c.httpClient, req, respBody, c.retryPolicy, c.rateLimiter)
+	return err
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ta9mi141/toggl-go/track"
 	"github.com/ta9mi141/toggl-go/track/internal"
 )
 
@@ -43,3 +44,31 @@ func TestNewAPIClientWithHTTPClient(t *testing.T) {
 		internal.Errorf(t, apiClient.httpClient, httpClient)
 	}
 }
+
+func TestNewAPIClientWithRetryPolicy(t *testing.T) {
+	retryPolicy := track.DefaultRetryPolicy()
+
+	apiClient := NewAPIClient(internal.APIToken, WithRetryPolicy(retryPolicy))
+
+	if !reflect.DeepEqual(apiClient.retryPolicy, retryPolicy) {
+		internal.Errorf(t, apiClient.retryPolicy, retryPolicy)
+	}
+}
+
+func TestNewAPIClientWithRateLimiter(t *testing.T) {
+	rateLimiter := track.NewRateLimiter(time.Second, 1)
+
+	apiClient := NewAPIClient(internal.APIToken, WithRateLimiter(rateLimiter))
+
+	if apiClient.rateLimiter != rateLimiter {
+		internal.Errorf(t, apiClient.rateLimiter, rateLimiter)
+	}
+}
+
+func TestNewAPIClientWithoutValidation(t *testing.T) {
+	apiClient := NewAPIClient(internal.APIToken, WithoutValidation())
+
+	if !apiClient.skipValidation {
+		internal.Errorf(t, apiClient.skipValidation, true)
+	}
+}